@@ -2,10 +2,15 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
+
+	"github.com/uptrace/bun"
+	"go.mau.fi/whatsmeow/store/sqlstore"
 
 	"wazmeow/internal/config"
 	"wazmeow/internal/infra/database"
@@ -14,45 +19,71 @@ import (
 	"wazmeow/pkg/logger"
 )
 
+// httpServer is the part of the HTTP server driven by run
+type httpServer interface {
+	Start() error
+	Shutdown(ctx context.Context) error
+}
+
+// Startup stages, replaced in tests to inject failures
+var (
+	loadConfig    = config.Load
+	openDatabase  = database.NewConnection
+	runMigrations = database.RunMigrations
+	openStore     = store.NewContainer
+	newServer     = func(cfg *config.Config, db *bun.DB, waStore *sqlstore.Container) (httpServer, error) {
+		return http.NewServer(cfg, db, waStore)
+	}
+)
+
 func main() {
+	if err := run(); err != nil {
+		log.Fatalf("Server failed: %v", err)
+	}
+}
+
+// run initializes every component in order, releasing the ones already started if a later stage fails
+func run() error {
 	// Load configuration
-	cfg, err := config.Load()
+	cfg, err := loadConfig()
 	if err != nil {
-		log.Fatalf("Failed to load configuration: %v", err)
+		return fmt.Errorf("load configuration: %w", err)
 	}
 
 	// Initialize logger
 	logger.Init(cfg.Log.Level, cfg.Log.Format)
 
 	// Initialize database
-	db, err := database.NewConnection(cfg.Database)
+	db, err := openDatabase(cfg.Database)
 	if err != nil {
-		logger.Fatal().Err(err).Msg("Failed to connect to database")
+		return fmt.Errorf("connect to database: %w", err)
 	}
 	defer db.Close()
 
 	// Run migrations
-	if err := database.RunMigrations(db); err != nil {
-		logger.Fatal().Err(err).Msg("Failed to run database migrations")
+	if err := runMigrations(db); err != nil {
+		return fmt.Errorf("run database migrations: %w", err)
 	}
 
 	// Initialize WhatsApp store container
-	waStore, err := store.NewContainer(cfg.Database)
+	waStore, err := openStore(cfg.Database)
 	if err != nil {
-		logger.Fatal().Err(err).Msg("Failed to initialize WhatsApp store")
+		return fmt.Errorf("initialize WhatsApp store: %w", err)
 	}
+	defer waStore.Close()
 
 	// Initialize HTTP server
-	server, err := http.NewServer(cfg, db, waStore)
+	server, err := newServer(cfg, db, waStore)
 	if err != nil {
-		logger.Fatal().Err(err).Msg("Failed to initialize HTTP server")
+		return fmt.Errorf("initialize HTTP server: %w", err)
 	}
+	// Deferred after the store and database, so it runs before they are closed
+	defer shutdown(server, cfg.Server.ShutdownTimeout)
 
 	// Start server
+	serverErr := make(chan error, 1)
 	go func() {
-		if err := server.Start(); err != nil {
-			logger.Fatal().Err(err).Msg("Failed to start HTTP server")
-		}
+		serverErr <- server.Start()
 	}()
 
 	logger.Info().
@@ -60,15 +91,28 @@ func main() {
 		Int("port", cfg.Server.Port).
 		Msg("Server started successfully")
 
-	// Wait for interrupt signal
+	// Wait for interrupt signal or server failure
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
+	defer signal.Stop(quit)
+
+	var startErr error
+	select {
+	case <-quit:
+		logger.Info().Msg("Shutting down server...")
+	case startErr = <-serverErr:
+		logger.Error().Err(startErr).Msg("HTTP server stopped unexpectedly")
+	}
 
-	logger.Info().Msg("Shutting down server...")
+	if startErr != nil {
+		return fmt.Errorf("start HTTP server: %w", startErr)
+	}
+	return nil
+}
 
-	// Graceful shutdown
-	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+// shutdown gracefully stops the HTTP server and the WhatsApp sessions
+func shutdown(server httpServer, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
 	if err := server.Shutdown(ctx); err != nil {
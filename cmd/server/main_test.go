@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.mau.fi/whatsmeow/store/sqlstore"
+
+	"wazmeow/internal/config"
+)
+
+var errStage = errors.New("stage failed")
+
+// startupLog records the order in which resources are released
+type startupLog struct {
+	steps []string
+}
+
+func (l *startupLog) add(step string) {
+	l.steps = append(l.steps, step)
+}
+
+// trackedConnector never connects; sql.DB.Close calls its Close, which records the release
+type trackedConnector struct {
+	name string
+	log  *startupLog
+}
+
+func (c trackedConnector) Connect(context.Context) (driver.Conn, error) {
+	return nil, errors.New("no connections in tests")
+}
+
+func (c trackedConnector) Driver() driver.Driver {
+	return nil
+}
+
+func (c trackedConnector) Close() error {
+	c.log.add(c.name + " closed")
+	return nil
+}
+
+// fakeServer is an HTTP server whose Start fails with startErr
+type fakeServer struct {
+	startErr error
+	log      *startupLog
+}
+
+func (s *fakeServer) Start() error {
+	return s.startErr
+}
+
+func (s *fakeServer) Shutdown(context.Context) error {
+	s.log.add("server shutdown")
+	return nil
+}
+
+// stubStages replaces the startup stages, failing the named one, and restores them when the test ends
+func stubStages(t *testing.T, failing string, log *startupLog) {
+	t.Helper()
+	origConfig, origDatabase, origMigrations, origStore, origServer := loadConfig, openDatabase, runMigrations, openStore, newServer
+	t.Cleanup(func() {
+		loadConfig, openDatabase, runMigrations, openStore, newServer = origConfig, origDatabase, origMigrations, origStore, origServer
+	})
+
+	fail := func(stage string) error {
+		if stage == failing {
+			return errStage
+		}
+		return nil
+	}
+
+	loadConfig = func() (*config.Config, error) {
+		cfg := &config.Config{}
+		cfg.Log.Level = "error"
+		cfg.Server.ShutdownTimeout = time.Second
+		return cfg, nil
+	}
+	openDatabase = func(config.DatabaseConfig) (*bun.DB, error) {
+		if err := fail("database"); err != nil {
+			return nil, err
+		}
+		return bun.NewDB(sql.OpenDB(trackedConnector{name: "database", log: log}), pgdialect.New()), nil
+	}
+	runMigrations = func(*bun.DB) error {
+		return fail("migrations")
+	}
+	openStore = func(config.DatabaseConfig) (*sqlstore.Container, error) {
+		if err := fail("store"); err != nil {
+			return nil, err
+		}
+		return sqlstore.NewWithDB(sql.OpenDB(trackedConnector{name: "store", log: log}), "postgres", nil), nil
+	}
+	newServer = func(*config.Config, *bun.DB, *sqlstore.Container) (httpServer, error) {
+		if err := fail("server"); err != nil {
+			return nil, err
+		}
+		return &fakeServer{startErr: fail("start"), log: log}, nil
+	}
+}
+
+func TestRunReleasesResourcesOnFailure(t *testing.T) {
+	tests := []struct {
+		failing   string
+		wantErr   string
+		wantSteps []string
+	}{
+		{failing: "database", wantErr: "connect to database: stage failed"},
+		{failing: "migrations", wantErr: "run database migrations: stage failed", wantSteps: []string{"database closed"}},
+		{failing: "store", wantErr: "initialize WhatsApp store: stage failed", wantSteps: []string{"database closed"}},
+		{failing: "server", wantErr: "initialize HTTP server: stage failed", wantSteps: []string{"store closed", "database closed"}},
+		{
+			failing:   "start",
+			wantErr:   "start HTTP server: stage failed",
+			wantSteps: []string{"server shutdown", "store closed", "database closed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.failing, func(t *testing.T) {
+			log := &startupLog{}
+			stubStages(t, tt.failing, log)
+
+			err := run()
+			if !errors.Is(err, errStage) || err.Error() != tt.wantErr {
+				t.Fatalf("run() error = %v, want %q", err, tt.wantErr)
+			}
+			if !slices.Equal(log.steps, tt.wantSteps) {
+				t.Fatalf("released %v, want %v", log.steps, tt.wantSteps)
+			}
+		})
+	}
+}
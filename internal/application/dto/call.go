@@ -0,0 +1,7 @@
+package dto
+
+// RejectCallRequest represents the request to reject an incoming call
+type RejectCallRequest struct {
+	CallID string `json:"callID" validate:"required"`
+	From   string `json:"from,omitempty"`
+}
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"wazmeow/internal/domain/services"
+)
+
+// BatchGroupInfoRequest represents the request to fetch information about several groups
+type BatchGroupInfoRequest struct {
+	GroupJIDs []string `json:"groupJIDs" validate:"required"`
+}
+
+// GroupInfoResult represents the outcome of fetching a single group in a batch
+type GroupInfoResult struct {
+	GroupJID string              `json:"groupJID"`
+	Info     *services.GroupInfo `json:"info,omitempty"`
+	Error    string              `json:"error,omitempty"`
+}
+
+// BatchGroupInfoResponse represents the outcome of a batched group info fetch
+type BatchGroupInfoResponse struct {
+	Results   []GroupInfoResult `json:"results"`
+	Succeeded int               `json:"succeeded"`
+	Failed    int               `json:"failed"`
+}
+
+// ToBatchGroupInfoResponse builds the batch response, counting succeeded and failed groups
+func ToBatchGroupInfoResponse(results []GroupInfoResult) BatchGroupInfoResponse {
+	response := BatchGroupInfoResponse{Results: results}
+	for _, result := range results {
+		if result.Error == "" {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+	}
+	return response
+}
@@ -0,0 +1,41 @@
+package dto
+
+// MessageEstimateResponse represents the estimated size of a text message
+type MessageEstimateResponse struct {
+	Characters   int  `json:"characters"`
+	Bytes        int  `json:"bytes"`
+	UTF16Units   int  `json:"utf16Units"`
+	MaxLength    int  `json:"maxLength"`
+	ExceedsLimit bool `json:"exceedsLimit"`
+	Segments     int  `json:"segments"`
+	Emoji        int  `json:"emoji"`
+	NonASCII     bool `json:"nonAscii"`
+	Mentions     int  `json:"mentions"`
+}
+
+// MessagePreviewRequest represents the request to preview a text message
+type MessagePreviewRequest struct {
+	Body string `json:"body" validate:"required"`
+}
+
+// FormattingSpan represents a formatted region of a text body, with offsets in UTF-16 code units
+// as used by JavaScript strings and the WhatsApp clients
+type FormattingSpan struct {
+	Style string `json:"style"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+	Text  string `json:"text"`
+}
+
+// MentionPreview represents a mention found in a text body, with its offset in UTF-16 code units
+type MentionPreview struct {
+	Phone  string `json:"phone"`
+	Offset int    `json:"offset"`
+}
+
+// MessagePreviewResponse represents how a text message will render
+type MessagePreviewResponse struct {
+	Spans    []FormattingSpan `json:"spans"`
+	Mentions []MentionPreview `json:"mentions"`
+	Warnings []string         `json:"warnings"`
+}
@@ -8,24 +8,33 @@ import (
 
 // CreateSessionRequest represents the request to create a new session
 type CreateSessionRequest struct {
-	Name        string                  `json:"name" validate:"required"`
-	WebhookURL  string                  `json:"webhookURL,omitempty"`
-	Events      string                  `json:"events,omitempty"`
-	ProxyConfig *entities.ProxyConfig   `json:"proxyConfig,omitempty"`
+	Name         string                     `json:"name" validate:"required"`
+	ClaimedPhone string                     `json:"claimedPhone,omitempty"`
+	Persistent   *bool                      `json:"persistent,omitempty"`
+	WebhookURL   string                     `json:"webhookURL,omitempty"`
+	Events       string                     `json:"events,omitempty"`
+	ProxyConfig  *entities.ProxyConfig      `json:"proxyConfig,omitempty"`
+	AutoMarkRead bool                       `json:"autoMarkRead,omitempty"`
+	AutoReject   *SetAutoRejectCallsRequest `json:"autoRejectCalls,omitempty"`
 }
 
 // SessionResponse represents a session in API responses
 type SessionResponse struct {
-	ID          string                  `json:"id"`
-	Name        string                  `json:"name"`
-	Status      entities.SessionStatus  `json:"status"`
-	Phone       string                  `json:"phone,omitempty"`
-	DeviceJID   string                  `json:"deviceJID,omitempty"`
-	ProxyConfig *entities.ProxyConfig   `json:"proxyConfig,omitempty"`
-	WebhookURL  string                  `json:"webhookURL,omitempty"`
-	Events      string                  `json:"events,omitempty"`
-	CreatedAt   time.Time               `json:"createdAt"`
-	UpdatedAt   time.Time               `json:"updatedAt"`
+	ID                string                 `json:"id"`
+	Name              string                 `json:"name"`
+	Status            entities.SessionStatus `json:"status"`
+	Phone             string                 `json:"phone,omitempty"`
+	ClaimedPhone      string                 `json:"claimedPhone,omitempty"`
+	DeviceJID         string                 `json:"deviceJID,omitempty"`
+	ProxyConfig       *entities.ProxyConfig  `json:"proxyConfig,omitempty"`
+	WebhookURL        string                 `json:"webhookURL,omitempty"`
+	Events            string                 `json:"events,omitempty"`
+	AutoMarkRead      bool                   `json:"autoMarkRead"`
+	AutoRejectCalls   bool                   `json:"autoRejectCalls"`
+	AutoRejectMessage string                 `json:"autoRejectMessage,omitempty"`
+	Persistent        bool                   `json:"persistent"`
+	CreatedAt         time.Time              `json:"createdAt"`
+	UpdatedAt         time.Time              `json:"updatedAt"`
 }
 
 // SessionListResponse represents the response for listing sessions
@@ -64,6 +73,22 @@ type SetProxyRequest struct {
 	ProxyURL string `json:"proxyURL,omitempty"`
 }
 
+// SetAutoMarkReadRequest represents the request to toggle automatic read receipts
+type SetAutoMarkReadRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// SetAutoRejectCallsRequest represents the request to configure automatic call rejection
+type SetAutoRejectCallsRequest struct {
+	Enabled bool   `json:"enabled"`
+	Message string `json:"message,omitempty"`
+}
+
+// SetDebugRequest represents the request to toggle verbose client logging
+type SetDebugRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
 // QRCodeResponse represents the QR code response
 type QRCodeResponse struct {
 	QRCode string `json:"qrCode"`
@@ -76,21 +101,27 @@ type APIResponse struct {
 	Message string      `json:"message,omitempty"`
 	Data    interface{} `json:"data,omitempty"`
 	Error   string      `json:"error,omitempty"`
+	Code    string      `json:"code,omitempty"`
 }
 
 // ToSessionResponse converts a domain session to a response DTO
 func ToSessionResponse(session *entities.Session) SessionResponse {
 	return SessionResponse{
-		ID:          session.ID,
-		Name:        session.Name,
-		Status:      session.Status,
-		Phone:       session.Phone,
-		DeviceJID:   session.DeviceJID,
-		ProxyConfig: session.ProxyConfig,
-		WebhookURL:  session.WebhookURL,
-		Events:      session.Events,
-		CreatedAt:   session.CreatedAt,
-		UpdatedAt:   session.UpdatedAt,
+		ID:                session.ID,
+		Name:              session.Name,
+		Status:            session.Status,
+		Phone:             session.Phone,
+		ClaimedPhone:      session.ClaimedPhone,
+		DeviceJID:         session.DeviceJID,
+		ProxyConfig:       session.ProxyConfig,
+		WebhookURL:        session.WebhookURL,
+		Events:            session.Events,
+		AutoMarkRead:      session.AutoMarkRead,
+		AutoRejectCalls:   session.AutoRejectCalls,
+		AutoRejectMessage: session.AutoRejectMessage,
+		Persistent:        session.Persistent,
+		CreatedAt:         session.CreatedAt,
+		UpdatedAt:         session.UpdatedAt,
 	}
 }
 
@@ -105,3 +136,22 @@ func ToSessionListResponse(sessions []*entities.Session) SessionListResponse {
 		Total:    len(sessions),
 	}
 }
+
+// CreateAndConnectSessionResponse represents a session created and connected in one call
+type CreateAndConnectSessionResponse struct {
+	Session   SessionResponse `json:"session"`
+	QRCode    string          `json:"qrCode,omitempty"`
+	QRPending bool            `json:"qrPending"`
+}
+
+// SessionConfig represents the portable, non-secret configuration of a session
+type SessionConfig struct {
+	WebhookURL        string                `json:"webhookURL,omitempty"`
+	Events            string                `json:"events,omitempty"`
+	ProxyConfig       *entities.ProxyConfig `json:"proxyConfig,omitempty"`
+	AutoMarkRead      bool                  `json:"autoMarkRead"`
+	AutoRejectCalls   bool                  `json:"autoRejectCalls"`
+	AutoRejectMessage string                `json:"autoRejectMessage,omitempty"`
+	// RedactedFields lists secrets removed on export that must be set again after import
+	RedactedFields []string `json:"redactedFields,omitempty"`
+}
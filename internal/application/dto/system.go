@@ -0,0 +1,18 @@
+package dto
+
+// HealthResponse represents the health of this deployment and its WhatsApp clients
+type HealthResponse struct {
+	Status   string                 `json:"status"`
+	Service  string                 `json:"service"`
+	WhatsApp map[string]interface{} `json:"whatsapp"`
+}
+
+// CapabilitiesResponse represents the features and versions of this deployment
+type CapabilitiesResponse struct {
+	APIVersion       string          `json:"apiVersion"`
+	WhatsmeowVersion string          `json:"whatsmeowVersion"`
+	GoVersion        string          `json:"goVersion"`
+	Database         string          `json:"database"`
+	Features         map[string]bool `json:"features"`
+	Limits           map[string]int  `json:"limits"`
+}
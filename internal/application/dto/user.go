@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"wazmeow/internal/domain/services"
+)
+
+// UserDevicesResponse represents the devices of a contact
+type UserDevicesResponse struct {
+	Phone           string                `json:"phone"`
+	Devices         []services.UserDevice `json:"devices"`
+	MissingSessions int                   `json:"missingSessions"`
+}
+
+// ToUserDevicesResponse builds the devices response, counting devices without an encryption session
+func ToUserDevicesResponse(phone string, devices []services.UserDevice) UserDevicesResponse {
+	missing := 0
+	for _, device := range devices {
+		if !device.HasSession {
+			missing++
+		}
+	}
+	return UserDevicesResponse{
+		Phone:           phone,
+		Devices:         devices,
+		MissingSessions: missing,
+	}
+}
+
+// PrefetchContactsRequest represents the request to prefetch contacts
+type PrefetchContactsRequest struct {
+	Phones []string `json:"phones" validate:"required"`
+}
+
+// PrefetchContactsResponse represents the outcome of a contacts prefetch
+type PrefetchContactsResponse struct {
+	Results  []services.ContactPrefetchResult `json:"results"`
+	Resolved int                              `json:"resolved"`
+	Failed   int                              `json:"failed"`
+}
+
+// ToPrefetchContactsResponse builds the prefetch response, counting resolved and failed contacts
+func ToPrefetchContactsResponse(results []services.ContactPrefetchResult) PrefetchContactsResponse {
+	response := PrefetchContactsResponse{Results: results}
+	for _, result := range results {
+		if result.Resolved {
+			response.Resolved++
+		} else {
+			response.Failed++
+		}
+	}
+	return response
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/internal/application/usecases/call"
+	"wazmeow/internal/domain/services"
+	"wazmeow/pkg/logger"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// CallHandler handles HTTP requests for calls
+type CallHandler struct {
+	rejectUseCase *call.RejectCallUseCase
+}
+
+// NewCallHandler creates a new CallHandler
+func NewCallHandler(rejectUseCase *call.RejectCallUseCase) *CallHandler {
+	return &CallHandler{
+		rejectUseCase: rejectUseCase,
+	}
+}
+
+// RejectCall handles POST /call/{sessionID}/reject
+func (h *CallHandler) RejectCall(w http.ResponseWriter, r *http.Request) {
+	sessionID := chi.URLParam(r, "sessionID")
+
+	var req dto.RejectCallRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error().Err(err).Msg("Failed to decode reject call request")
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.rejectUseCase.Execute(r.Context(), sessionID, req); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, services.ErrCallNotFound) {
+			status = http.StatusNotFound
+		}
+		respondError(w, status, fmt.Sprintf("Failed to reject call: %v", err))
+		return
+	}
+
+	respondSuccess(w, http.StatusOK, "Call rejected", map[string]interface{}{
+		"sessionId": sessionID,
+		"callId":    req.CallID,
+	})
+}
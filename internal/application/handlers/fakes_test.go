@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"wazmeow/internal/domain/services"
+)
+
+// fakeWhatsAppService implements the methods of services.WhatsAppService used by the handler tests;
+// calling any other method panics through the nil embedded interface
+type fakeWhatsAppService struct {
+	services.WhatsAppService
+	groups map[string]*services.GroupInfo
+}
+
+func (s *fakeWhatsAppService) GetGroupInfo(_ context.Context, _, groupJID string) (*services.GroupInfo, error) {
+	info, ok := s.groups[groupJID]
+	if !ok {
+		return nil, errors.New("group not found")
+	}
+	return info, nil
+}
@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/internal/application/usecases/group"
+	"wazmeow/internal/domain/services"
+	"wazmeow/pkg/logger"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// csvFlushInterval is the number of rows written between flushes when streaming CSV
+const csvFlushInterval = 500
+
+// GroupHandler handles HTTP requests for group management
+type GroupHandler struct {
+	exportParticipantsUseCase *group.ExportParticipantsUseCase
+	batchInfoUseCase          *group.BatchGroupInfoUseCase
+	settingsUseCase           *group.GetGroupSettingsUseCase
+}
+
+// NewGroupHandler creates a new GroupHandler
+func NewGroupHandler(
+	exportParticipantsUseCase *group.ExportParticipantsUseCase,
+	batchInfoUseCase *group.BatchGroupInfoUseCase,
+	settingsUseCase *group.GetGroupSettingsUseCase,
+) *GroupHandler {
+	return &GroupHandler{
+		exportParticipantsUseCase: exportParticipantsUseCase,
+		batchInfoUseCase:          batchInfoUseCase,
+		settingsUseCase:           settingsUseCase,
+	}
+}
+
+// GetGroupSettings handles GET /group/{sessionID}/settings
+func (h *GroupHandler) GetGroupSettings(w http.ResponseWriter, r *http.Request) {
+	sessionID := chi.URLParam(r, "sessionID")
+	groupJID := r.URL.Query().Get("groupJID")
+
+	if groupJID == "" {
+		respondError(w, http.StatusBadRequest, "groupJID query parameter is required")
+		return
+	}
+
+	settings, err := h.settingsUseCase.Execute(r.Context(), sessionID, groupJID)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to get group settings: %v", err))
+		return
+	}
+
+	respondSuccess(w, http.StatusOK, "Group settings retrieved", settings)
+}
+
+// GetGroupInfoBatch handles POST /group/{sessionID}/info/batch
+func (h *GroupHandler) GetGroupInfoBatch(w http.ResponseWriter, r *http.Request) {
+	sessionID := chi.URLParam(r, "sessionID")
+
+	var req dto.BatchGroupInfoRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error().Err(err).Msg("Failed to decode batch group info request")
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	response, err := h.batchInfoUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, fmt.Sprintf("Failed to get group info: %v", err))
+		return
+	}
+
+	respondSuccess(w, http.StatusOK, "Group info retrieved", response)
+}
+
+// ExportParticipantsCSV handles GET /group/{sessionID}/participants.csv
+func (h *GroupHandler) ExportParticipantsCSV(w http.ResponseWriter, r *http.Request) {
+	sessionID := chi.URLParam(r, "sessionID")
+	groupJID := r.URL.Query().Get("groupJID")
+
+	if groupJID == "" {
+		respondError(w, http.StatusBadRequest, "groupJID query parameter is required")
+		return
+	}
+
+	info, err := h.exportParticipantsUseCase.Execute(r.Context(), sessionID, groupJID)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to export participants: %v", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s-participants.csv"`, info.JID))
+	w.WriteHeader(http.StatusOK)
+
+	if err := writeParticipantsCSV(w, info.Participants); err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Str("groupJID", groupJID).Msg("Failed to stream participants CSV")
+	}
+}
+
+// writeParticipantsCSV streams the participants as CSV rows, flushing periodically
+func writeParticipantsCSV(w http.ResponseWriter, participants []services.GroupParticipant) error {
+	writer := csv.NewWriter(w)
+	flusher, _ := w.(http.Flusher)
+
+	if err := writer.Write([]string{"jid", "phone", "admin", "joinMethod"}); err != nil {
+		return err
+	}
+
+	for i, participant := range participants {
+		record := []string{
+			participant.JID,
+			participant.Phone,
+			participantRole(participant),
+			participant.JoinMethod,
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+
+		if (i+1)%csvFlushInterval == 0 {
+			writer.Flush()
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// participantRole returns the admin status of a participant
+func participantRole(participant services.GroupParticipant) string {
+	switch {
+	case participant.IsSuperAdmin:
+		return "superadmin"
+	case participant.IsAdmin:
+		return "admin"
+	default:
+		return "member"
+	}
+}
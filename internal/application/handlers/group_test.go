@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"wazmeow/internal/application/usecases/group"
+	"wazmeow/internal/domain/services"
+)
+
+func TestExportParticipantsCSV(t *testing.T) {
+	const groupJID = "120363000000000000@g.us"
+	svc := &fakeWhatsAppService{groups: map[string]*services.GroupInfo{
+		groupJID: {
+			JID:  groupJID,
+			Name: "Team",
+			Participants: []services.GroupParticipant{
+				{JID: "5511111111111@s.whatsapp.net", Phone: "5511111111111", IsAdmin: true, IsSuperAdmin: true},
+				{JID: "5511222222222@s.whatsapp.net", Phone: "5511222222222", IsAdmin: true},
+				{JID: "123456789@lid", Phone: "5511333333333", JoinMethod: "invite_request"},
+				{JID: "987654321@lid"},
+			},
+		},
+	}}
+	h := NewGroupHandler(group.NewExportParticipantsUseCase(svc), nil, nil)
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantRows   [][]string
+	}{
+		{
+			name:       "sample group",
+			query:      "?groupJID=" + groupJID,
+			wantStatus: http.StatusOK,
+			wantRows: [][]string{
+				{"jid", "phone", "admin", "joinMethod"},
+				{"5511111111111@s.whatsapp.net", "5511111111111", "superadmin", ""},
+				{"5511222222222@s.whatsapp.net", "5511222222222", "admin", ""},
+				{"123456789@lid", "5511333333333", "member", "invite_request"},
+				{"987654321@lid", "", "member", ""},
+			},
+		},
+		{name: "missing group JID", query: "", wantStatus: http.StatusBadRequest},
+		{name: "unknown group", query: "?groupJID=120363999999999999@g.us", wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ExportParticipantsCSV(rec, httptest.NewRequest(http.MethodGet, "/group/s1/participants.csv"+tt.query, nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantRows == nil {
+				return
+			}
+
+			if contentType := rec.Header().Get("Content-Type"); contentType != "text/csv; charset=utf-8" {
+				t.Fatalf("Content-Type = %q", contentType)
+			}
+			rows, err := csv.NewReader(rec.Body).ReadAll()
+			if err != nil {
+				t.Fatalf("parse CSV: %v", err)
+			}
+			if !reflect.DeepEqual(rows, tt.wantRows) {
+				t.Fatalf("rows = %v, want %v", rows, tt.wantRows)
+			}
+		})
+	}
+}
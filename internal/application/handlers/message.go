@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/internal/application/usecases/message"
+	"wazmeow/pkg/logger"
+)
+
+// MessageHandler handles HTTP requests for message utilities
+type MessageHandler struct {
+	estimateUseCase *message.EstimateMessageUseCase
+	previewUseCase  *message.PreviewMessageUseCase
+}
+
+// NewMessageHandler creates a new MessageHandler
+func NewMessageHandler(estimateUseCase *message.EstimateMessageUseCase, previewUseCase *message.PreviewMessageUseCase) *MessageHandler {
+	return &MessageHandler{
+		estimateUseCase: estimateUseCase,
+		previewUseCase:  previewUseCase,
+	}
+}
+
+// EstimateMessage handles GET /message/estimate
+func (h *MessageHandler) EstimateMessage(w http.ResponseWriter, r *http.Request) {
+	body := r.URL.Query().Get("body")
+
+	response, err := h.estimateUseCase.Execute(r.Context(), body)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondSuccess(w, http.StatusOK, "Message estimate computed", response)
+}
+
+// PreviewMessage handles POST /message/preview
+func (h *MessageHandler) PreviewMessage(w http.ResponseWriter, r *http.Request) {
+	var req dto.MessagePreviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error().Err(err).Msg("Failed to decode message preview request")
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	response, err := h.previewUseCase.Execute(r.Context(), req)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondSuccess(w, http.StatusOK, "Message preview computed", response)
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"wazmeow/internal/application/usecases/profile"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// ProfileHandler handles HTTP requests for the account profile
+type ProfileHandler struct {
+	getPushNameUseCase  *profile.GetPushNameUseCase
+	syncPushNameUseCase *profile.SyncPushNameUseCase
+	getPictureUseCase   *profile.GetProfilePictureUseCase
+}
+
+// NewProfileHandler creates a new ProfileHandler
+func NewProfileHandler(
+	getPushNameUseCase *profile.GetPushNameUseCase,
+	syncPushNameUseCase *profile.SyncPushNameUseCase,
+	getPictureUseCase *profile.GetProfilePictureUseCase,
+) *ProfileHandler {
+	return &ProfileHandler{
+		getPushNameUseCase:  getPushNameUseCase,
+		syncPushNameUseCase: syncPushNameUseCase,
+		getPictureUseCase:   getPictureUseCase,
+	}
+}
+
+// GetPushName handles GET /profile/{sessionID}/pushname
+func (h *ProfileHandler) GetPushName(w http.ResponseWriter, r *http.Request) {
+	sessionID := chi.URLParam(r, "sessionID")
+
+	info, err := h.getPushNameUseCase.Execute(r.Context(), sessionID)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to get push name: %v", err))
+		return
+	}
+
+	respondSuccess(w, http.StatusOK, "Push name retrieved successfully", info)
+}
+
+// SyncPushName handles POST /profile/{sessionID}/pushname/sync
+func (h *ProfileHandler) SyncPushName(w http.ResponseWriter, r *http.Request) {
+	sessionID := chi.URLParam(r, "sessionID")
+
+	info, err := h.syncPushNameUseCase.Execute(r.Context(), sessionID)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to sync push name: %v", err))
+		return
+	}
+
+	respondSuccess(w, http.StatusOK, "Push name synced", info)
+}
+
+// GetProfilePicture handles GET /profile/{sessionID}/picture
+func (h *ProfileHandler) GetProfilePicture(w http.ResponseWriter, r *http.Request) {
+	sessionID := chi.URLParam(r, "sessionID")
+
+	picture, err := h.getPictureUseCase.Execute(r.Context(), sessionID)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to get profile picture: %v", err))
+		return
+	}
+
+	respondSuccess(w, http.StatusOK, "Profile picture retrieved successfully", picture)
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/pkg/logger"
+)
+
+// respondSuccess sends a successful response
+func respondSuccess(w http.ResponseWriter, status int, message string, data interface{}) {
+	response := dto.APIResponse{
+		Success: true,
+		Message: message,
+		Data:    data,
+	}
+	respondJSON(w, status, response)
+}
+
+// respondError sends an error response
+func respondError(w http.ResponseWriter, status int, message string) {
+	response := dto.APIResponse{
+		Success: false,
+		Error:   message,
+	}
+	respondJSON(w, status, response)
+}
+
+// respondErrorCode sends an error response with a machine-readable error code
+func respondErrorCode(w http.ResponseWriter, status int, code, message string) {
+	response := dto.APIResponse{
+		Success: false,
+		Error:   message,
+		Code:    code,
+	}
+	respondJSON(w, status, response)
+}
+
+// respondJSON sends a JSON response
+func respondJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		logger.Error().Err(err).Msg("Failed to encode JSON response")
+	}
+}
@@ -2,13 +2,16 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"wazmeow/internal/application/dto"
 	"wazmeow/internal/application/usecases/session"
 	"wazmeow/internal/domain/entities"
 	"wazmeow/internal/infra/whatsapp"
+	"wazmeow/internal/infra/whatsapp/events"
 	"wazmeow/pkg/logger"
 
 	"github.com/go-chi/chi/v5"
@@ -16,10 +19,15 @@ import (
 
 // SessionHandler handles HTTP requests for session management
 type SessionHandler struct {
-	createUseCase   *session.CreateSessionUseCase
-	listUseCase     *session.ListSessionsUseCase
-	connectUseCase  *session.ConnectSessionUseCase
-	whatsappService *whatsapp.Service
+	createUseCase     *session.CreateSessionUseCase
+	listUseCase       *session.ListSessionsUseCase
+	connectUseCase    *session.ConnectSessionUseCase
+	autoReadUseCase   *session.SetAutoMarkReadUseCase
+	autoRejectUseCase *session.SetAutoRejectCallsUseCase
+	createConnectUC   *session.CreateAndConnectSessionUseCase
+	exportConfigUC    *session.ExportSessionConfigUseCase
+	importConfigUC    *session.ImportSessionConfigUseCase
+	whatsappService   *whatsapp.Service
 }
 
 // NewSessionHandler creates a new SessionHandler
@@ -27,13 +35,23 @@ func NewSessionHandler(
 	createUseCase *session.CreateSessionUseCase,
 	listUseCase *session.ListSessionsUseCase,
 	connectUseCase *session.ConnectSessionUseCase,
+	autoReadUseCase *session.SetAutoMarkReadUseCase,
+	autoRejectUseCase *session.SetAutoRejectCallsUseCase,
+	createConnectUC *session.CreateAndConnectSessionUseCase,
+	exportConfigUC *session.ExportSessionConfigUseCase,
+	importConfigUC *session.ImportSessionConfigUseCase,
 	whatsappService *whatsapp.Service,
 ) *SessionHandler {
 	return &SessionHandler{
-		createUseCase:   createUseCase,
-		listUseCase:     listUseCase,
-		connectUseCase:  connectUseCase,
-		whatsappService: whatsappService,
+		createUseCase:     createUseCase,
+		listUseCase:       listUseCase,
+		connectUseCase:    connectUseCase,
+		autoReadUseCase:   autoReadUseCase,
+		autoRejectUseCase: autoRejectUseCase,
+		createConnectUC:   createConnectUC,
+		exportConfigUC:    exportConfigUC,
+		importConfigUC:    importConfigUC,
+		whatsappService:   whatsappService,
 	}
 }
 
@@ -42,18 +60,22 @@ func (h *SessionHandler) CreateSession(w http.ResponseWriter, r *http.Request) {
 	var req dto.CreateSessionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		logger.Error().Err(err).Msg("Failed to decode create session request")
-		h.respondError(w, http.StatusBadRequest, "Invalid request body")
+		respondError(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	response, err := h.createUseCase.Execute(r.Context(), req)
+	if errors.Is(err, entities.ErrSessionNameExists) {
+		respondErrorCode(w, http.StatusConflict, "SESSION_NAME_EXISTS", err.Error())
+		return
+	}
 	if err != nil {
 		logger.Error().Err(err).Msg("Failed to create session")
-		h.respondError(w, http.StatusInternalServerError, err.Error())
+		respondError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	h.respondSuccess(w, http.StatusCreated, "Session created successfully", response)
+	respondSuccess(w, http.StatusCreated, "Session created successfully", response)
 }
 
 // ListSessions handles GET /sessions/list
@@ -61,11 +83,34 @@ func (h *SessionHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
 	response, err := h.listUseCase.Execute(r.Context())
 	if err != nil {
 		logger.Error().Err(err).Msg("Failed to list sessions")
-		h.respondError(w, http.StatusInternalServerError, err.Error())
+		respondError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	h.respondSuccess(w, http.StatusOK, "Sessions retrieved successfully", response)
+	respondSuccess(w, http.StatusOK, "Sessions retrieved successfully", response)
+}
+
+// CreateAndConnectSession handles POST /sessions/create-and-connect
+func (h *SessionHandler) CreateAndConnectSession(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateSessionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error().Err(err).Msg("Failed to decode create and connect session request")
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	response, err := h.createConnectUC.Execute(r.Context(), req)
+	if errors.Is(err, entities.ErrSessionNameExists) {
+		respondErrorCode(w, http.StatusConflict, "SESSION_NAME_EXISTS", err.Error())
+		return
+	}
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to create and connect session")
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondSuccess(w, http.StatusCreated, "Session created and connection initiated", response)
 }
 
 // ConnectSession handles POST /sessions/{sessionID}/connect
@@ -75,18 +120,18 @@ func (h *SessionHandler) ConnectSession(w http.ResponseWriter, r *http.Request)
 	var req dto.ConnectSessionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		logger.Error().Err(err).Msg("Failed to decode connect session request")
-		h.respondError(w, http.StatusBadRequest, "Invalid request body")
+		respondError(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	response, err := h.connectUseCase.Execute(r.Context(), sessionID, req)
 	if err != nil {
 		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to connect session")
-		h.respondError(w, http.StatusInternalServerError, err.Error())
+		respondError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	h.respondJSON(w, http.StatusOK, response)
+	respondJSON(w, http.StatusOK, response)
 }
 
 // GetSessionInfo handles GET /sessions/{sessionID}/info
@@ -97,11 +142,11 @@ func (h *SessionHandler) GetSessionInfo(w http.ResponseWriter, r *http.Request)
 	info, err := h.whatsappService.GetSessionInfo(sessionID)
 	if err != nil {
 		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to get session info")
-		h.respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to get session info: %v", err))
+		respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to get session info: %v", err))
 		return
 	}
 
-	h.respondSuccess(w, http.StatusOK, "Session info retrieved successfully", info)
+	respondSuccess(w, http.StatusOK, "Session info retrieved successfully", info)
 }
 
 // DeleteSession handles DELETE /sessions/{sessionID}
@@ -109,7 +154,7 @@ func (h *SessionHandler) DeleteSession(w http.ResponseWriter, r *http.Request) {
 	_ = chi.URLParam(r, "sessionID") // TODO: Use sessionID when implementing
 
 	// TODO: Implement delete session logic
-	h.respondError(w, http.StatusNotImplemented, "Not implemented yet")
+	respondError(w, http.StatusNotImplemented, "Not implemented yet")
 }
 
 // LogoutSession handles POST /sessions/{sessionID}/logout
@@ -119,11 +164,11 @@ func (h *SessionHandler) LogoutSession(w http.ResponseWriter, r *http.Request) {
 	// Logout from WhatsApp service
 	if err := h.whatsappService.Logout(r.Context(), sessionID); err != nil {
 		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to logout session")
-		h.respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to logout: %v", err))
+		respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to logout: %v", err))
 		return
 	}
 
-	h.respondSuccess(w, http.StatusOK, "Session logged out successfully", map[string]interface{}{
+	respondSuccess(w, http.StatusOK, "Session logged out successfully", map[string]interface{}{
 		"sessionId": sessionID,
 		"status":    "disconnected",
 		"message":   "Session has been logged out from WhatsApp",
@@ -136,13 +181,17 @@ func (h *SessionHandler) GetQRCode(w http.ResponseWriter, r *http.Request) {
 
 	// Get QR code from WhatsApp service
 	qrCode, err := h.whatsappService.GetQRCode(r.Context(), sessionID)
+	if errors.Is(err, events.ErrPairRejected) {
+		respondErrorCode(w, http.StatusConflict, "PAIR_REJECTED", err.Error())
+		return
+	}
 	if err != nil {
 		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to get QR code")
-		h.respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to get QR code: %v", err))
+		respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to get QR code: %v", err))
 		return
 	}
 
-	h.respondSuccess(w, http.StatusOK, "QR code retrieved successfully", map[string]interface{}{
+	respondSuccess(w, http.StatusOK, "QR code retrieved successfully", map[string]interface{}{
 		"sessionId": sessionID,
 		"qrCode":    qrCode,
 		"message":   "Scan this QR code with WhatsApp to authenticate",
@@ -156,7 +205,7 @@ func (h *SessionHandler) PairPhone(w http.ResponseWriter, r *http.Request) {
 	var req dto.PairPhoneRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		logger.Error().Err(err).Msg("Failed to decode pair phone request")
-		h.respondError(w, http.StatusBadRequest, "Invalid request body")
+		respondError(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
@@ -164,11 +213,11 @@ func (h *SessionHandler) PairPhone(w http.ResponseWriter, r *http.Request) {
 	linkingCode, err := h.whatsappService.PairPhone(r.Context(), sessionID, req.Phone)
 	if err != nil {
 		logger.Error().Err(err).Str("sessionId", sessionID).Str("phone", req.Phone).Msg("Failed to pair phone")
-		h.respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to pair phone: %v", err))
+		respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to pair phone: %v", err))
 		return
 	}
 
-	h.respondSuccess(w, http.StatusOK, "Phone pairing initiated", map[string]interface{}{
+	respondSuccess(w, http.StatusOK, "Phone pairing initiated", map[string]interface{}{
 		"sessionId":   sessionID,
 		"phone":       req.Phone,
 		"linkingCode": linkingCode,
@@ -183,7 +232,7 @@ func (h *SessionHandler) SetProxy(w http.ResponseWriter, r *http.Request) {
 	var req dto.SetProxyRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		logger.Error().Err(err).Msg("Failed to decode set proxy request")
-		h.respondError(w, http.StatusBadRequest, "Invalid request body")
+		respondError(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
@@ -192,45 +241,188 @@ func (h *SessionHandler) SetProxy(w http.ResponseWriter, r *http.Request) {
 		Enabled:  req.Enabled,
 		ProxyURL: req.ProxyURL,
 	}
+	if err := proxyConfig.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
 	if err := h.whatsappService.SetProxy(sessionID, proxyConfig); err != nil {
 		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to set proxy")
-		h.respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to set proxy: %v", err))
+		respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to set proxy: %v", err))
 		return
 	}
 
-	h.respondSuccess(w, http.StatusOK, "Proxy configuration updated", map[string]interface{}{
+	respondSuccess(w, http.StatusOK, "Proxy configuration updated", map[string]interface{}{
 		"sessionId":   sessionID,
 		"proxyConfig": proxyConfig,
 		"message":     "Proxy configuration has been updated",
 	})
 }
 
-// respondSuccess sends a successful response
-func (h *SessionHandler) respondSuccess(w http.ResponseWriter, status int, message string, data interface{}) {
-	response := dto.APIResponse{
-		Success: true,
-		Message: message,
-		Data:    data,
+// SetAutoMarkRead handles POST /sessions/{sessionID}/autoread/set
+func (h *SessionHandler) SetAutoMarkRead(w http.ResponseWriter, r *http.Request) {
+	sessionID := chi.URLParam(r, "sessionID")
+
+	var req dto.SetAutoMarkReadRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error().Err(err).Msg("Failed to decode set auto mark read request")
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
 	}
-	h.respondJSON(w, status, response)
+
+	response, err := h.autoReadUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to set auto mark read")
+		respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to set auto mark read: %v", err))
+		return
+	}
+
+	respondSuccess(w, http.StatusOK, "Auto mark read updated", response)
+}
+
+// SetAutoRejectCalls handles POST /sessions/{sessionID}/calls/autoreject/set
+func (h *SessionHandler) SetAutoRejectCalls(w http.ResponseWriter, r *http.Request) {
+	sessionID := chi.URLParam(r, "sessionID")
+
+	var req dto.SetAutoRejectCallsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error().Err(err).Msg("Failed to decode set auto reject calls request")
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	response, err := h.autoRejectUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to set auto reject calls")
+		respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to set auto reject calls: %v", err))
+		return
+	}
+
+	respondSuccess(w, http.StatusOK, "Auto reject calls updated", response)
 }
 
-// respondError sends an error response
-func (h *SessionHandler) respondError(w http.ResponseWriter, status int, message string) {
-	response := dto.APIResponse{
-		Success: false,
-		Error:   message,
+// ExportConfig handles GET /sessions/{sessionID}/config/export
+func (h *SessionHandler) ExportConfig(w http.ResponseWriter, r *http.Request) {
+	sessionID := chi.URLParam(r, "sessionID")
+
+	config, err := h.exportConfigUC.Execute(r.Context(), sessionID)
+	if err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to export session config")
+		respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to export session config: %v", err))
+		return
 	}
-	h.respondJSON(w, status, response)
+
+	respondSuccess(w, http.StatusOK, "Session config exported", config)
 }
 
-// respondJSON sends a JSON response
-func (h *SessionHandler) respondJSON(w http.ResponseWriter, status int, data interface{}) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(status)
+// ImportConfig handles POST /sessions/{sessionID}/config/import
+func (h *SessionHandler) ImportConfig(w http.ResponseWriter, r *http.Request) {
+	sessionID := chi.URLParam(r, "sessionID")
 
-	if err := json.NewEncoder(w).Encode(data); err != nil {
-		logger.Error().Err(err).Msg("Failed to encode JSON response")
+	var config dto.SessionConfig
+	if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+		logger.Error().Err(err).Msg("Failed to decode import session config request")
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
 	}
+
+	response, err := h.importConfigUC.Execute(r.Context(), sessionID, config)
+	if errors.Is(err, entities.ErrInvalidURL) {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to import session config")
+		respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to import session config: %v", err))
+		return
+	}
+
+	respondSuccess(w, http.StatusOK, "Session config imported", response)
+}
+
+// GetRecentEvents handles GET /sessions/{sessionID}/events/recent
+func (h *SessionHandler) GetRecentEvents(w http.ResponseWriter, r *http.Request) {
+	sessionID := chi.URLParam(r, "sessionID")
+
+	var since time.Time
+	if value := r.URL.Query().Get("since"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "Invalid since parameter, expected RFC3339 timestamp")
+			return
+		}
+		since = parsed
+	}
+
+	events, err := h.whatsappService.GetRecentEvents(sessionID, since)
+	if err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to get recent events")
+		respondError(w, http.StatusNotFound, fmt.Sprintf("Failed to get recent events: %v", err))
+		return
+	}
+
+	respondSuccess(w, http.StatusOK, "Recent events retrieved successfully", map[string]interface{}{
+		"sessionId": sessionID,
+		"events":    events,
+		"total":     len(events),
+	})
+}
+
+// SetDebug handles PUT /sessions/{sessionID}/debug
+func (h *SessionHandler) SetDebug(w http.ResponseWriter, r *http.Request) {
+	sessionID := chi.URLParam(r, "sessionID")
+
+	var req dto.SetDebugRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error().Err(err).Msg("Failed to decode set debug request")
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.whatsappService.SetDebug(sessionID, req.Enabled); err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to set debug logging")
+		respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to set debug logging: %v", err))
+		return
+	}
+
+	respondSuccess(w, http.StatusOK, "Debug logging updated", map[string]interface{}{
+		"sessionId": sessionID,
+		"debug":     req.Enabled,
+	})
+}
+
+// GetEventHandlers handles GET /admin/sessions/{sessionID}/handlers
+func (h *SessionHandler) GetEventHandlers(w http.ResponseWriter, r *http.Request) {
+	sessionID := chi.URLParam(r, "sessionID")
+
+	ids, err := h.whatsappService.GetEventHandlers(sessionID)
+	if err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to get event handlers")
+		respondError(w, http.StatusNotFound, fmt.Sprintf("Failed to get event handlers: %v", err))
+		return
+	}
+
+	respondSuccess(w, http.StatusOK, "Event handlers retrieved successfully", map[string]interface{}{
+		"sessionId":  sessionID,
+		"handlerIds": ids,
+		"count":      len(ids),
+	})
+}
+
+// ResetEventHandlers handles POST /admin/sessions/{sessionID}/handlers/reset
+func (h *SessionHandler) ResetEventHandlers(w http.ResponseWriter, r *http.Request) {
+	sessionID := chi.URLParam(r, "sessionID")
+
+	ids, err := h.whatsappService.ResetEventHandlers(sessionID)
+	if err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to reset event handlers")
+		respondError(w, http.StatusNotFound, fmt.Sprintf("Failed to reset event handlers: %v", err))
+		return
+	}
+
+	respondSuccess(w, http.StatusOK, "Event handlers reset successfully", map[string]interface{}{
+		"sessionId":  sessionID,
+		"handlerIds": ids,
+		"count":      len(ids),
+	})
 }
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/internal/application/usecases/session"
+	"wazmeow/internal/domain/entities"
+	"wazmeow/internal/domain/repositories"
+)
+
+// namedSessionRepo stores sessions by name and rejects duplicates like the database index
+type namedSessionRepo struct {
+	repositories.SessionRepository
+	sessions map[string]*entities.Session
+}
+
+func (r *namedSessionRepo) Create(_ context.Context, s *entities.Session) error {
+	if _, exists := r.sessions[s.Name]; exists {
+		return entities.ErrSessionNameExists
+	}
+	r.sessions[s.Name] = s
+	return nil
+}
+
+func (r *namedSessionRepo) GetByName(_ context.Context, name string) (*entities.Session, error) {
+	return r.sessions[name], nil
+}
+
+func TestCreateSessionStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		existing   string
+		autoSuffix bool
+		wantStatus int
+		wantCode   string
+		wantName   string
+	}{
+		{name: "created", wantStatus: http.StatusCreated, wantName: "sales"},
+		{name: "duplicate name conflicts", existing: "sales", wantStatus: http.StatusConflict, wantCode: "SESSION_NAME_EXISTS"},
+		{name: "duplicate name suffixed", existing: "sales", autoSuffix: true, wantStatus: http.StatusCreated, wantName: "sales-2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &namedSessionRepo{sessions: make(map[string]*entities.Session)}
+			if tt.existing != "" {
+				repo.sessions[tt.existing] = entities.NewSession(tt.existing)
+			}
+			h := NewSessionHandler(session.NewCreateSessionUseCase(repo, tt.autoSuffix), nil, nil, nil, nil, nil, nil, nil, nil)
+
+			req := httptest.NewRequest(http.MethodPost, "/sessions/add", strings.NewReader(`{"name":"sales"}`))
+			rec := httptest.NewRecorder()
+			h.CreateSession(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+
+			var response struct {
+				dto.APIResponse
+				Data *dto.SessionResponse `json:"data"`
+			}
+			if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if response.Code != tt.wantCode {
+				t.Fatalf("code = %q, want %q", response.Code, tt.wantCode)
+			}
+			if tt.wantName != "" && (response.Data == nil || response.Data.Name != tt.wantName) {
+				t.Fatalf("data = %+v, want name %q", response.Data, tt.wantName)
+			}
+		})
+	}
+}
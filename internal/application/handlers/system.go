@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"net/http"
+
+	"wazmeow/internal/application/usecases/system"
+)
+
+// SystemHandler handles HTTP requests about the deployment itself
+type SystemHandler struct {
+	healthUseCase        *system.GetHealthUseCase
+	capabilitiesUseCase  *system.GetCapabilitiesUseCase
+	eventRegistryUseCase *system.GetEventRegistryUseCase
+}
+
+// NewSystemHandler creates a new SystemHandler
+func NewSystemHandler(healthUseCase *system.GetHealthUseCase, capabilitiesUseCase *system.GetCapabilitiesUseCase, eventRegistryUseCase *system.GetEventRegistryUseCase) *SystemHandler {
+	return &SystemHandler{
+		healthUseCase:        healthUseCase,
+		capabilitiesUseCase:  capabilitiesUseCase,
+		eventRegistryUseCase: eventRegistryUseCase,
+	}
+}
+
+// Health handles GET /health; the body is not wrapped so that probes can read status directly
+func (h *SystemHandler) Health(w http.ResponseWriter, r *http.Request) {
+	respondJSON(w, http.StatusOK, h.healthUseCase.Execute(r.Context()))
+}
+
+// GetCapabilities handles GET /capabilities
+func (h *SystemHandler) GetCapabilities(w http.ResponseWriter, r *http.Request) {
+	respondSuccess(w, http.StatusOK, "Capabilities retrieved successfully", h.capabilitiesUseCase.Execute(r.Context()))
+}
+
+// GetEventRegistry handles GET /events/registry
+func (h *SystemHandler) GetEventRegistry(w http.ResponseWriter, r *http.Request) {
+	registry := h.eventRegistryUseCase.Execute(r.Context())
+	respondSuccess(w, http.StatusOK, "Event registry retrieved successfully", map[string]interface{}{
+		"events": registry,
+		"total":  len(registry),
+	})
+}
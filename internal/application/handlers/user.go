@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/internal/application/usecases/user"
+	"wazmeow/pkg/logger"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// UserHandler handles HTTP requests for contact/user queries
+type UserHandler struct {
+	getDevicesUseCase       *user.GetDevicesUseCase
+	prefetchContactsUseCase *user.PrefetchContactsUseCase
+}
+
+// NewUserHandler creates a new UserHandler
+func NewUserHandler(getDevicesUseCase *user.GetDevicesUseCase, prefetchContactsUseCase *user.PrefetchContactsUseCase) *UserHandler {
+	return &UserHandler{
+		getDevicesUseCase:       getDevicesUseCase,
+		prefetchContactsUseCase: prefetchContactsUseCase,
+	}
+}
+
+// GetDevices handles GET /user/{sessionID}/devices
+func (h *UserHandler) GetDevices(w http.ResponseWriter, r *http.Request) {
+	sessionID := chi.URLParam(r, "sessionID")
+	phone := r.URL.Query().Get("phone")
+
+	if phone == "" {
+		respondError(w, http.StatusBadRequest, "phone query parameter is required")
+		return
+	}
+
+	devices, err := h.getDevicesUseCase.Execute(r.Context(), sessionID, phone)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to get user devices: %v", err))
+		return
+	}
+
+	respondSuccess(w, http.StatusOK, "User devices retrieved successfully", dto.ToUserDevicesResponse(phone, devices))
+}
+
+// PrefetchContacts handles POST /user/{sessionID}/contacts/prefetch (also served as /contacts/import)
+// It only warms up the user info and device caches; encryption sessions are created on the first send.
+func (h *UserHandler) PrefetchContacts(w http.ResponseWriter, r *http.Request) {
+	sessionID := chi.URLParam(r, "sessionID")
+
+	var req dto.PrefetchContactsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error().Err(err).Msg("Failed to decode prefetch contacts request")
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	response, err := h.prefetchContactsUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		if errors.Is(err, user.ErrNoContacts) || errors.Is(err, user.ErrTooManyContacts) {
+			respondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		respondError(w, http.StatusInternalServerError, fmt.Sprintf("Failed to prefetch contacts: %v", err))
+		return
+	}
+
+	respondSuccess(w, http.StatusOK, "Contacts prefetched", response)
+}
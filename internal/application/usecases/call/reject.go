@@ -0,0 +1,36 @@
+package call
+
+import (
+	"context"
+	"errors"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/internal/domain/services"
+	"wazmeow/pkg/logger"
+)
+
+// RejectCallUseCase handles rejecting incoming calls
+type RejectCallUseCase struct {
+	whatsappSvc services.WhatsAppService
+}
+
+// NewRejectCallUseCase creates a new RejectCallUseCase
+func NewRejectCallUseCase(whatsappSvc services.WhatsAppService) *RejectCallUseCase {
+	return &RejectCallUseCase{
+		whatsappSvc: whatsappSvc,
+	}
+}
+
+// Execute rejects a call that was recently offered to the session
+func (uc *RejectCallUseCase) Execute(ctx context.Context, sessionID string, req dto.RejectCallRequest) error {
+	if req.CallID == "" {
+		return errors.New("callID is required")
+	}
+
+	if err := uc.whatsappSvc.RejectCall(ctx, sessionID, req.CallID, req.From); err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Str("callId", req.CallID).Msg("Failed to reject call")
+		return err
+	}
+
+	return nil
+}
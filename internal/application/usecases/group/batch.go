@@ -0,0 +1,96 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/internal/domain/services"
+	"wazmeow/pkg/logger"
+)
+
+const (
+	// maxBatchGroups is the maximum number of groups accepted per batch request
+	maxBatchGroups = 50
+	// batchGroupConcurrency is the maximum number of group info requests in flight at once
+	batchGroupConcurrency = 5
+	// groupInfoCacheTTL is how long a fetched group info is reused
+	groupInfoCacheTTL = 30 * time.Second
+	// maxGroupInfoCacheEntries bounds the group infos cached across all sessions
+	maxGroupInfoCacheEntries = 1000
+)
+
+// BatchGroupInfoUseCase handles fetching information about several groups at once
+type BatchGroupInfoUseCase struct {
+	whatsappSvc services.WhatsAppService
+	cache       *groupInfoCache
+}
+
+// NewBatchGroupInfoUseCase creates a new BatchGroupInfoUseCase
+func NewBatchGroupInfoUseCase(whatsappSvc services.WhatsAppService) *BatchGroupInfoUseCase {
+	return &BatchGroupInfoUseCase{
+		whatsappSvc: whatsappSvc,
+		cache:       newGroupInfoCache(groupInfoCacheTTL, maxGroupInfoCacheEntries),
+	}
+}
+
+// Forget drops the cached group infos of a session, called when the session is removed
+func (uc *BatchGroupInfoUseCase) Forget(sessionID string) {
+	uc.cache.purge(sessionID)
+}
+
+// Execute fetches the groups concurrently, reporting per-group failures without failing the batch
+func (uc *BatchGroupInfoUseCase) Execute(ctx context.Context, sessionID string, req dto.BatchGroupInfoRequest) (*dto.BatchGroupInfoResponse, error) {
+	if len(req.GroupJIDs) == 0 {
+		return nil, errors.New("at least one group JID is required")
+	}
+	if len(req.GroupJIDs) > maxBatchGroups {
+		return nil, fmt.Errorf("too many groups: maximum is %d per request", maxBatchGroups)
+	}
+
+	results := make([]dto.GroupInfoResult, len(req.GroupJIDs))
+	semaphore := make(chan struct{}, batchGroupConcurrency)
+
+	var wg sync.WaitGroup
+	for i, groupJID := range req.GroupJIDs {
+		wg.Add(1)
+		go func(i int, groupJID string) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			results[i] = uc.fetch(ctx, sessionID, groupJID)
+		}(i, groupJID)
+	}
+	wg.Wait()
+
+	response := dto.ToBatchGroupInfoResponse(results)
+
+	logger.Info().
+		Str("sessionId", sessionID).
+		Int("succeeded", response.Succeeded).
+		Int("failed", response.Failed).
+		Msg("Batch group info completed")
+
+	return &response, nil
+}
+
+// fetch returns the info of a single group, using the cache when still fresh
+func (uc *BatchGroupInfoUseCase) fetch(ctx context.Context, sessionID, groupJID string) dto.GroupInfoResult {
+	if info, ok := uc.cache.get(sessionID, groupJID); ok {
+		return dto.GroupInfoResult{GroupJID: groupJID, Info: info}
+	}
+
+	info, err := uc.whatsappSvc.GetGroupInfo(ctx, sessionID, groupJID)
+	if err != nil {
+		logger.Warn().Err(err).Str("sessionId", sessionID).Str("groupJID", groupJID).Msg("Failed to get group info in batch")
+		return dto.GroupInfoResult{GroupJID: groupJID, Error: err.Error()}
+	}
+
+	uc.cache.put(sessionID, groupJID, info)
+	return dto.GroupInfoResult{GroupJID: groupJID, Info: info}
+}
@@ -0,0 +1,171 @@
+package group
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"wazmeow/internal/application/dto"
+)
+
+func groupJIDs(n int) []string {
+	jids := make([]string, n)
+	for i := range jids {
+		jids[i] = fmt.Sprintf("1203630000000000%02d@g.us", i)
+	}
+	return jids
+}
+
+func TestBatchGroupInfo(t *testing.T) {
+	jids := groupJIDs(12)
+
+	tests := []struct {
+		name          string
+		groups        []string
+		failing       []string
+		wantErr       bool
+		wantSucceeded int
+		wantFailed    int
+	}{
+		{name: "no groups", wantErr: true},
+		{name: "too many groups", groups: groupJIDs(maxBatchGroups + 1), wantErr: true},
+		{name: "all succeed", groups: jids, wantSucceeded: 12},
+		{name: "partial failure", groups: jids, failing: []string{jids[1], jids[7]}, wantSucceeded: 10, wantFailed: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newFakeWhatsAppService(tt.failing...)
+			svc.delay = 10 * time.Millisecond
+			uc := NewBatchGroupInfoUseCase(svc)
+
+			response, err := uc.Execute(context.Background(), "s1", dto.BatchGroupInfoRequest{GroupJIDs: tt.groups})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Execute() error = nil, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+
+			if response.Succeeded != tt.wantSucceeded || response.Failed != tt.wantFailed {
+				t.Fatalf("succeeded/failed = %d/%d, want %d/%d", response.Succeeded, response.Failed, tt.wantSucceeded, tt.wantFailed)
+			}
+			for i, result := range response.Results {
+				if result.GroupJID != tt.groups[i] {
+					t.Fatalf("result %d is for %s, want %s", i, result.GroupJID, tt.groups[i])
+				}
+				if (result.Error != "") != svc.failing[result.GroupJID] {
+					t.Fatalf("result %d = %+v", i, result)
+				}
+			}
+
+			if _, maxInFlight := svc.stats(); maxInFlight < 2 || maxInFlight > batchGroupConcurrency {
+				t.Fatalf("max concurrent fetches = %d, want between 2 and %d", maxInFlight, batchGroupConcurrency)
+			}
+		})
+	}
+}
+
+func TestBatchGroupInfoCache(t *testing.T) {
+	jids := groupJIDs(3)
+	svc := newFakeWhatsAppService(jids[2])
+	uc := NewBatchGroupInfoUseCase(svc)
+	req := dto.BatchGroupInfoRequest{GroupJIDs: jids}
+
+	for i := 0; i < 2; i++ {
+		if _, err := uc.Execute(context.Background(), "s1", req); err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+	}
+	// Successful fetches are cached, failures are retried
+	if fetches, _ := svc.stats(); fetches != 4 {
+		t.Fatalf("fetches = %d, want 4", fetches)
+	}
+
+	uc.Forget("s1")
+	if size := uc.cache.size(); size != 0 {
+		t.Fatalf("cache size after Forget = %d, want 0", size)
+	}
+	if _, err := uc.Execute(context.Background(), "s1", req); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if fetches, _ := svc.stats(); fetches != 7 {
+		t.Fatalf("fetches after Forget = %d, want 7", fetches)
+	}
+}
+
+func TestGroupInfoCacheEviction(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefill  func(c *groupInfoCache)
+		wantSize int
+		wantKept []groupInfoKey
+		wantGone []groupInfoKey
+		putGroup string
+	}{
+		{
+			name: "expired entries evicted first",
+			prefill: func(c *groupInfoCache) {
+				c.put("s1", "a", nil)
+				c.put("s1", "b", nil)
+				c.put("s1", "c", nil)
+				c.entries[groupInfoKey{"s1", "a"}] = cachedGroupInfo{fetchedAt: time.Now().Add(-time.Hour)}
+				c.entries[groupInfoKey{"s1", "b"}] = cachedGroupInfo{fetchedAt: time.Now().Add(-time.Hour)}
+			},
+			putGroup: "d",
+			wantSize: 2,
+			wantKept: []groupInfoKey{{"s1", "c"}, {"s1", "d"}},
+			wantGone: []groupInfoKey{{"s1", "a"}, {"s1", "b"}},
+		},
+		{
+			name: "oldest entry evicted when none expired",
+			prefill: func(c *groupInfoCache) {
+				c.put("s1", "a", nil)
+				c.put("s2", "b", nil)
+				c.put("s1", "c", nil)
+				c.entries[groupInfoKey{"s2", "b"}] = cachedGroupInfo{fetchedAt: time.Now().Add(-time.Second)}
+			},
+			putGroup: "d",
+			wantSize: 3,
+			wantKept: []groupInfoKey{{"s1", "a"}, {"s1", "c"}, {"s1", "d"}},
+			wantGone: []groupInfoKey{{"s2", "b"}},
+		},
+		{
+			name: "refreshing an entry does not evict",
+			prefill: func(c *groupInfoCache) {
+				c.put("s1", "a", nil)
+				c.put("s1", "b", nil)
+				c.put("s1", "c", nil)
+			},
+			putGroup: "a",
+			wantSize: 3,
+			wantKept: []groupInfoKey{{"s1", "a"}, {"s1", "b"}, {"s1", "c"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newGroupInfoCache(time.Minute, 3)
+			tt.prefill(c)
+			c.put("s1", tt.putGroup, nil)
+
+			if size := c.size(); size != tt.wantSize {
+				t.Fatalf("size = %d, want %d", size, tt.wantSize)
+			}
+			for _, key := range tt.wantKept {
+				if _, ok := c.get(key.sessionID, key.groupJID); !ok {
+					t.Fatalf("%v evicted, want kept", key)
+				}
+			}
+			for _, key := range tt.wantGone {
+				if _, ok := c.get(key.sessionID, key.groupJID); ok {
+					t.Fatalf("%v kept, want evicted", key)
+				}
+			}
+		})
+	}
+}
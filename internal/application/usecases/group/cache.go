@@ -0,0 +1,103 @@
+package group
+
+import (
+	"sync"
+	"time"
+
+	"wazmeow/internal/domain/services"
+)
+
+// groupInfoKey identifies a cached group info
+type groupInfoKey struct {
+	sessionID string
+	groupJID  string
+}
+
+// cachedGroupInfo holds a group info together with its fetch time
+type cachedGroupInfo struct {
+	info      *services.GroupInfo
+	fetchedAt time.Time
+}
+
+// groupInfoCache keeps fetched group infos for a short TTL, bounded to maxEntries
+type groupInfoCache struct {
+	mu         sync.Mutex
+	entries    map[groupInfoKey]cachedGroupInfo
+	ttl        time.Duration
+	maxEntries int
+}
+
+// newGroupInfoCache creates an empty group info cache
+func newGroupInfoCache(ttl time.Duration, maxEntries int) *groupInfoCache {
+	return &groupInfoCache{
+		entries:    make(map[groupInfoKey]cachedGroupInfo),
+		ttl:        ttl,
+		maxEntries: maxEntries,
+	}
+}
+
+// get returns a cached group info if it is still fresh, dropping it once expired
+func (c *groupInfoCache) get(sessionID, groupJID string) (*services.GroupInfo, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := groupInfoKey{sessionID, groupJID}
+	cached, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Since(cached.fetchedAt) >= c.ttl {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return cached.info, true
+}
+
+// put stores a group info, evicting expired entries and then the oldest ones when full
+func (c *groupInfoCache) put(sessionID, groupJID string, info *services.GroupInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := groupInfoKey{sessionID, groupJID}
+	if _, exists := c.entries[key]; !exists && len(c.entries) >= c.maxEntries {
+		c.evict()
+	}
+	c.entries[key] = cachedGroupInfo{info: info, fetchedAt: time.Now()}
+}
+
+// evict drops the expired entries, or the oldest one if none has expired. Callers hold mu.
+func (c *groupInfoCache) evict() {
+	var oldestKey groupInfoKey
+	var oldest time.Time
+	for key, cached := range c.entries {
+		if time.Since(cached.fetchedAt) >= c.ttl {
+			delete(c.entries, key)
+			continue
+		}
+		if oldest.IsZero() || cached.fetchedAt.Before(oldest) {
+			oldestKey, oldest = key, cached.fetchedAt
+		}
+	}
+	if len(c.entries) >= c.maxEntries {
+		delete(c.entries, oldestKey)
+	}
+}
+
+// purge drops every entry of a session
+func (c *groupInfoCache) purge(sessionID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.entries {
+		if key.sessionID == sessionID {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// size returns the number of cached entries
+func (c *groupInfoCache) size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
@@ -0,0 +1,64 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"wazmeow/internal/domain/services"
+)
+
+// fakeWhatsAppService implements the group methods of services.WhatsAppService; other methods panic
+type fakeWhatsAppService struct {
+	services.WhatsAppService
+
+	mu          sync.Mutex
+	failing     map[string]bool
+	settings    *services.GroupSettings
+	delay       time.Duration
+	inFlight    int
+	maxInFlight int
+	fetches     int
+}
+
+func newFakeWhatsAppService(failing ...string) *fakeWhatsAppService {
+	svc := &fakeWhatsAppService{failing: make(map[string]bool)}
+	for _, groupJID := range failing {
+		svc.failing[groupJID] = true
+	}
+	return svc
+}
+
+func (s *fakeWhatsAppService) GetGroupInfo(_ context.Context, _, groupJID string) (*services.GroupInfo, error) {
+	s.mu.Lock()
+	s.fetches++
+	s.inFlight++
+	if s.inFlight > s.maxInFlight {
+		s.maxInFlight = s.inFlight
+	}
+	s.mu.Unlock()
+
+	time.Sleep(s.delay)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inFlight--
+	if s.failing[groupJID] {
+		return nil, errors.New("not a participant")
+	}
+	return &services.GroupInfo{JID: groupJID, Name: "group " + groupJID}, nil
+}
+
+func (s *fakeWhatsAppService) GetGroupSettings(_ context.Context, _, groupJID string) (*services.GroupSettings, error) {
+	if s.failing[groupJID] {
+		return nil, errors.New("not a participant")
+	}
+	return s.settings, nil
+}
+
+func (s *fakeWhatsAppService) stats() (fetches, maxInFlight int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.fetches, s.maxInFlight
+}
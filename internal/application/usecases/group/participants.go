@@ -0,0 +1,38 @@
+package group
+
+import (
+	"context"
+	"errors"
+
+	"wazmeow/internal/domain/services"
+	"wazmeow/pkg/logger"
+)
+
+// ExportParticipantsUseCase handles exporting the participants of a group
+type ExportParticipantsUseCase struct {
+	whatsappSvc services.WhatsAppService
+}
+
+// NewExportParticipantsUseCase creates a new ExportParticipantsUseCase
+func NewExportParticipantsUseCase(whatsappSvc services.WhatsAppService) *ExportParticipantsUseCase {
+	return &ExportParticipantsUseCase{
+		whatsappSvc: whatsappSvc,
+	}
+}
+
+// Execute retrieves the group with its participants
+func (uc *ExportParticipantsUseCase) Execute(ctx context.Context, sessionID, groupJID string) (*services.GroupInfo, error) {
+	if groupJID == "" {
+		return nil, errors.New("group JID is required")
+	}
+
+	logger.Info().Str("sessionId", sessionID).Str("groupJID", groupJID).Msg("Exporting group participants")
+
+	info, err := uc.whatsappSvc.GetGroupInfo(ctx, sessionID, groupJID)
+	if err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Str("groupJID", groupJID).Msg("Failed to get group info")
+		return nil, err
+	}
+
+	return info, nil
+}
@@ -0,0 +1,36 @@
+package group
+
+import (
+	"context"
+	"errors"
+
+	"wazmeow/internal/domain/services"
+	"wazmeow/pkg/logger"
+)
+
+// GetGroupSettingsUseCase handles retrieving the current settings of a group
+type GetGroupSettingsUseCase struct {
+	whatsappSvc services.WhatsAppService
+}
+
+// NewGetGroupSettingsUseCase creates a new GetGroupSettingsUseCase
+func NewGetGroupSettingsUseCase(whatsappSvc services.WhatsAppService) *GetGroupSettingsUseCase {
+	return &GetGroupSettingsUseCase{
+		whatsappSvc: whatsappSvc,
+	}
+}
+
+// Execute retrieves the announce, locked, member add mode, join approval and disappearing timer settings
+func (uc *GetGroupSettingsUseCase) Execute(ctx context.Context, sessionID, groupJID string) (*services.GroupSettings, error) {
+	if groupJID == "" {
+		return nil, errors.New("group JID is required")
+	}
+
+	settings, err := uc.whatsappSvc.GetGroupSettings(ctx, sessionID, groupJID)
+	if err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Str("groupJID", groupJID).Msg("Failed to get group settings")
+		return nil, err
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,52 @@
+package group
+
+import (
+	"context"
+	"testing"
+
+	"wazmeow/internal/domain/services"
+)
+
+func TestGetGroupSettings(t *testing.T) {
+	settings := &services.GroupSettings{
+		JID:                  "120363000000000000@g.us",
+		Announce:             true,
+		Locked:               true,
+		MemberAddMode:        "admin_add",
+		JoinApprovalRequired: true,
+		DisappearingTimer:    86400,
+	}
+
+	tests := []struct {
+		name     string
+		groupJID string
+		failing  []string
+		want     *services.GroupSettings
+		wantErr  bool
+	}{
+		{name: "all settings reported", groupJID: settings.JID, want: settings},
+		{name: "group JID required", groupJID: "", wantErr: true},
+		{name: "service error", groupJID: settings.JID, failing: []string{settings.JID}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newFakeWhatsAppService(tt.failing...)
+			svc.settings = settings
+
+			got, err := NewGetGroupSettingsUseCase(svc).Execute(context.Background(), "s1", tt.groupJID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Execute() error = nil, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if *got != *tt.want {
+				t.Fatalf("Execute() = %+v, want %+v", *got, *tt.want)
+			}
+		})
+	}
+}
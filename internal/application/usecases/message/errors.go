@@ -0,0 +1,14 @@
+package message
+
+import "errors"
+
+var (
+	// ErrEmptyBody is returned when a text body is empty
+	ErrEmptyBody = errors.New("message body is required")
+
+	// ErrBodyTooLong is returned when a text body exceeds the single message limit
+	ErrBodyTooLong = errors.New("message body exceeds the maximum length")
+
+	// ErrInvalidReaction is returned when a reaction is not a single emoji nor the remove sentinel
+	ErrInvalidReaction = errors.New("reaction must be a single emoji or \"remove\"")
+)
@@ -0,0 +1,55 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"unicode/utf8"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/pkg/logger"
+)
+
+// EstimateMessageUseCase handles estimating the size of a text message before sending
+type EstimateMessageUseCase struct {
+	maxLength int
+}
+
+// NewEstimateMessageUseCase creates a new EstimateMessageUseCase.
+// maxLength is the maximum number of characters of a single text message.
+func NewEstimateMessageUseCase(maxLength int) *EstimateMessageUseCase {
+	if maxLength <= 0 {
+		maxLength = DefaultMaxTextLength
+	}
+	return &EstimateMessageUseCase{
+		maxLength: maxLength,
+	}
+}
+
+// Execute computes the size, encoding and mention details of a text body
+func (uc *EstimateMessageUseCase) Execute(ctx context.Context, body string) (*dto.MessageEstimateResponse, error) {
+	if body == "" {
+		return nil, ErrEmptyBody
+	}
+
+	characters := utf8.RuneCountInString(body)
+	segments := (characters + uc.maxLength - 1) / uc.maxLength
+
+	response := &dto.MessageEstimateResponse{
+		Characters:   characters,
+		Bytes:        len(body),
+		UTF16Units:   countUTF16Units(body),
+		MaxLength:    uc.maxLength,
+		ExceedsLimit: errors.Is(ValidateBodyLength(body, uc.maxLength), ErrBodyTooLong),
+		Segments:     segments,
+		Emoji:        countEmoji(body),
+		NonASCII:     len(body) != characters,
+		Mentions:     len(ExtractMentions(body)),
+	}
+
+	logger.Debug().
+		Int("characters", response.Characters).
+		Bool("exceedsLimit", response.ExceedsLimit).
+		Msg("Message estimate computed")
+
+	return response, nil
+}
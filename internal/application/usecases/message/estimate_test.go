@@ -0,0 +1,79 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"wazmeow/internal/application/dto"
+)
+
+func TestEstimateMessage(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		maxLength int
+		want      dto.MessageEstimateResponse
+		wantErr   error
+	}{
+		{name: "empty", body: "", maxLength: 10, wantErr: ErrEmptyBody},
+		{
+			name:      "plain ASCII",
+			body:      "hello",
+			maxLength: 10,
+			want:      dto.MessageEstimateResponse{Characters: 5, Bytes: 5, UTF16Units: 5, MaxLength: 10, Segments: 1},
+		},
+		{
+			name:      "exactly at limit",
+			body:      strings.Repeat("a", 10),
+			maxLength: 10,
+			want:      dto.MessageEstimateResponse{Characters: 10, Bytes: 10, UTF16Units: 10, MaxLength: 10, Segments: 1},
+		},
+		{
+			name:      "over limit",
+			body:      strings.Repeat("a", 25),
+			maxLength: 10,
+			want:      dto.MessageEstimateResponse{Characters: 25, Bytes: 25, UTF16Units: 25, MaxLength: 10, ExceedsLimit: true, Segments: 3},
+		},
+		{
+			name:      "accents",
+			body:      "olá",
+			maxLength: 10,
+			want:      dto.MessageEstimateResponse{Characters: 3, Bytes: 4, UTF16Units: 3, MaxLength: 10, Segments: 1, NonASCII: true},
+		},
+		{
+			name:      "emoji use surrogate pairs",
+			body:      "hi 😀👍",
+			maxLength: 10,
+			want:      dto.MessageEstimateResponse{Characters: 5, Bytes: 11, UTF16Units: 7, MaxLength: 10, Segments: 1, Emoji: 2, NonASCII: true},
+		},
+		{
+			name:      "mentions",
+			body:      "@5511999999999 @5511888888888 @5511999999999",
+			maxLength: 100,
+			want:      dto.MessageEstimateResponse{Characters: 44, Bytes: 44, UTF16Units: 44, MaxLength: 100, Segments: 1, Mentions: 2},
+		},
+		{
+			name:      "default limit",
+			body:      "hi",
+			maxLength: 0,
+			want:      dto.MessageEstimateResponse{Characters: 2, Bytes: 2, UTF16Units: 2, MaxLength: DefaultMaxTextLength, Segments: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewEstimateMessageUseCase(tt.maxLength).Execute(context.Background(), tt.body)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Execute() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if *got != tt.want {
+				t.Fatalf("Execute() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"unicode"
+	"unicode/utf16"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/pkg/logger"
+)
+
+// monospaceFence delimits monospace blocks, which may span several lines
+const monospaceFence = "```"
+
+// inlineMarkers maps the inline formatting markers to their style names
+var inlineMarkers = map[rune]string{
+	'*': "bold",
+	'_': "italic",
+	'~': "strikethrough",
+}
+
+// PreviewMessageUseCase handles previewing how a text message will render
+type PreviewMessageUseCase struct {
+	maxLength int
+}
+
+// NewPreviewMessageUseCase creates a new PreviewMessageUseCase.
+// maxLength is the maximum number of characters of a single text message.
+func NewPreviewMessageUseCase(maxLength int) *PreviewMessageUseCase {
+	if maxLength <= 0 {
+		maxLength = DefaultMaxTextLength
+	}
+	return &PreviewMessageUseCase{
+		maxLength: maxLength,
+	}
+}
+
+// Execute parses the formatting spans and mentions of a body and reports malformed markup
+func (uc *PreviewMessageUseCase) Execute(ctx context.Context, req dto.MessagePreviewRequest) (*dto.MessagePreviewResponse, error) {
+	if req.Body == "" {
+		return nil, ErrEmptyBody
+	}
+
+	spans, warnings := parseFormatting(req.Body)
+	if errors.Is(ValidateBodyLength(req.Body, uc.maxLength), ErrBodyTooLong) {
+		warnings = append(warnings, fmt.Sprintf("body exceeds the maximum length of %d characters", uc.maxLength))
+	}
+
+	response := &dto.MessagePreviewResponse{
+		Spans:    spans,
+		Mentions: findMentions(req.Body),
+		Warnings: warnings,
+	}
+
+	logger.Debug().
+		Int("spans", len(response.Spans)).
+		Int("mentions", len(response.Mentions)).
+		Int("warnings", len(response.Warnings)).
+		Msg("Message preview computed")
+
+	return response, nil
+}
+
+// parseFormatting finds monospace blocks and inline bold/italic/strikethrough spans.
+// Inline markers follow WhatsApp rules: they open after a boundary and before a non-space,
+// close after a non-space and before a boundary, and never cross a line break.
+// Offsets in spans and warnings are reported in UTF-16 code units.
+func parseFormatting(body string) ([]dto.FormattingSpan, []string) {
+	runes := []rune(body)
+	offsets := utf16Offsets(runes)
+	spans := []dto.FormattingSpan{}
+	warnings := []string{}
+
+	// Monospace blocks; their content is not parsed further
+	inCode := make([]bool, len(runes))
+	fences := findFences(runes)
+	for k := 0; k+1 < len(fences); k += 2 {
+		start, end := fences[k], fences[k+1]+len(monospaceFence)
+		for i := start; i < end; i++ {
+			inCode[i] = true
+		}
+		spans = append(spans, dto.FormattingSpan{
+			Style: "monospace",
+			Start: offsets[start],
+			End:   offsets[end],
+			Text:  string(runes[start+len(monospaceFence) : end-len(monospaceFence)]),
+		})
+	}
+	if len(fences)%2 == 1 {
+		warnings = append(warnings, fmt.Sprintf("unclosed monospace marker %q at offset %d", monospaceFence, offsets[fences[len(fences)-1]]))
+	}
+
+	// Inline markers
+	consumed := make([]bool, len(runes))
+	for i, r := range runes {
+		style, ok := inlineMarkers[r]
+		if !ok || inCode[i] || consumed[i] || !canOpenMarker(runes, i) {
+			continue
+		}
+
+		closeAt := -1
+		for j := i + 2; j < len(runes) && runes[j] != '\n' && !inCode[j]; j++ {
+			if runes[j] == r && !consumed[j] && canCloseMarker(runes, j) {
+				closeAt = j
+				break
+			}
+		}
+		if closeAt < 0 {
+			warnings = append(warnings, fmt.Sprintf("unclosed %s marker %q at offset %d", style, r, offsets[i]))
+			continue
+		}
+
+		// The same marker inside the span is literal text, WhatsApp does not nest a style in itself
+		for k := i; k <= closeAt; k++ {
+			if runes[k] == r {
+				consumed[k] = true
+			}
+		}
+		spans = append(spans, dto.FormattingSpan{
+			Style: style,
+			Start: offsets[i],
+			End:   offsets[closeAt+1],
+			Text:  string(runes[i+1 : closeAt]),
+		})
+	}
+
+	sort.SliceStable(spans, func(a, b int) bool {
+		return spans[a].Start < spans[b].Start
+	})
+
+	return spans, warnings
+}
+
+// utf16Offsets returns, for each rune index and the end of the body, its offset in UTF-16 code units
+func utf16Offsets(runes []rune) []int {
+	offsets := make([]int, len(runes)+1)
+	for i, r := range runes {
+		offsets[i+1] = offsets[i] + utf16.RuneLen(r)
+	}
+	return offsets
+}
+
+// findFences returns the rune offsets of non-overlapping monospace fences
+func findFences(runes []rune) []int {
+	var fences []int
+	fence := []rune(monospaceFence)
+	for i := 0; i+len(fence) <= len(runes); i++ {
+		if string(runes[i:i+len(fence)]) == monospaceFence {
+			fences = append(fences, i)
+			i += len(fence) - 1
+		}
+	}
+	return fences
+}
+
+// canOpenMarker reports whether the marker at i can start a span
+func canOpenMarker(runes []rune, i int) bool {
+	if i > 0 && !isMarkerBoundary(runes[i-1]) {
+		return false
+	}
+	return i+1 < len(runes) && !unicode.IsSpace(runes[i+1])
+}
+
+// canCloseMarker reports whether the marker at j can end a span
+func canCloseMarker(runes []rune, j int) bool {
+	if unicode.IsSpace(runes[j-1]) {
+		return false
+	}
+	return j+1 == len(runes) || isMarkerBoundary(runes[j+1])
+}
+
+// isMarkerBoundary reports whether a rune may sit next to the outer side of a marker
+func isMarkerBoundary(r rune) bool {
+	_, marker := inlineMarkers[r]
+	return marker || unicode.IsSpace(r) || unicode.IsPunct(r)
+}
+
+// findMentions returns every mention in a body with its offset in UTF-16 code units
+func findMentions(body string) []dto.MentionPreview {
+	mentions := []dto.MentionPreview{}
+	for _, match := range mentionPattern.FindAllStringSubmatchIndex(body, -1) {
+		mentions = append(mentions, dto.MentionPreview{
+			Phone:  body[match[2]:match[3]],
+			Offset: len(utf16.Encode([]rune(body[:match[0]]))),
+		})
+	}
+	return mentions
+}
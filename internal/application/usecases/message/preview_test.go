@@ -0,0 +1,130 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"wazmeow/internal/application/dto"
+)
+
+func TestPreviewMessage(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		maxLength    int
+		wantSpans    []dto.FormattingSpan
+		wantMentions []dto.MentionPreview
+		wantWarnings []string
+		wantErr      error
+	}{
+		{name: "empty", body: "", wantErr: ErrEmptyBody},
+		{name: "plain text", body: "hello there"},
+		{
+			name:      "bold",
+			body:      "*bold*",
+			wantSpans: []dto.FormattingSpan{{Style: "bold", Start: 0, End: 6, Text: "bold"}},
+		},
+		{
+			name: "italic and strikethrough",
+			body: "hi _it_ ~st~",
+			wantSpans: []dto.FormattingSpan{
+				{Style: "italic", Start: 3, End: 7, Text: "it"},
+				{Style: "strikethrough", Start: 8, End: 12, Text: "st"},
+			},
+		},
+		{
+			name: "repeated markers",
+			body: "*a* *b*",
+			wantSpans: []dto.FormattingSpan{
+				{Style: "bold", Start: 0, End: 3, Text: "a"},
+				{Style: "bold", Start: 4, End: 7, Text: "b"},
+			},
+		},
+		{
+			name: "nested styles",
+			body: "*_both_*",
+			wantSpans: []dto.FormattingSpan{
+				{Style: "bold", Start: 0, End: 8, Text: "_both_"},
+				{Style: "italic", Start: 1, End: 7, Text: "both"},
+			},
+		},
+		{
+			name:      "offsets in UTF-16 after emoji",
+			body:      "😀 *bold*",
+			wantSpans: []dto.FormattingSpan{{Style: "bold", Start: 3, End: 9, Text: "bold"}},
+		},
+		{name: "markers inside words ignored", body: "2*3*4"},
+		{
+			name:         "unclosed marker",
+			body:         "*not bold",
+			wantWarnings: []string{"unclosed bold marker '*' at offset 0"},
+		},
+		{
+			name:         "marker does not cross lines",
+			body:         "*a\nb*",
+			wantWarnings: []string{"unclosed bold marker '*' at offset 0"},
+		},
+		{
+			name:      "monospace content not parsed",
+			body:      "```code *x*```",
+			wantSpans: []dto.FormattingSpan{{Style: "monospace", Start: 0, End: 14, Text: "code *x*"}},
+		},
+		{
+			name:         "unclosed monospace",
+			body:         "```code",
+			wantWarnings: []string{"unclosed monospace marker \"```\" at offset 0"},
+		},
+		{
+			name: "mentions with UTF-16 offsets",
+			body: "hi @5511999999999 and 😀@5511888888888",
+			wantMentions: []dto.MentionPreview{
+				{Phone: "5511999999999", Offset: 3},
+				{Phone: "5511888888888", Offset: 24},
+			},
+		},
+		{
+			name:         "repeated mention listed each time",
+			body:         "@5511999999999 @5511999999999",
+			wantMentions: []dto.MentionPreview{{Phone: "5511999999999", Offset: 0}, {Phone: "5511999999999", Offset: 15}},
+		},
+		{
+			name:         "too long",
+			body:         "hello world",
+			maxLength:    5,
+			wantWarnings: []string{"body exceeds the maximum length of 5 characters"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewPreviewMessageUseCase(tt.maxLength).Execute(context.Background(), dto.MessagePreviewRequest{Body: tt.body})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Execute() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			want := dto.MessagePreviewResponse{
+				Spans:    tt.wantSpans,
+				Mentions: tt.wantMentions,
+				Warnings: tt.wantWarnings,
+			}
+			// The response always carries empty lists rather than nulls
+			if want.Spans == nil {
+				want.Spans = []dto.FormattingSpan{}
+			}
+			if want.Mentions == nil {
+				want.Mentions = []dto.MentionPreview{}
+			}
+			if want.Warnings == nil {
+				want.Warnings = []string{}
+			}
+			if !reflect.DeepEqual(*got, want) {
+				t.Fatalf("Execute() = %+v, want %+v", *got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package message
+
+// ReactionRemove is the reaction body that removes a previous reaction
+const ReactionRemove = "remove"
+
+const (
+	zeroWidthJoiner   = 0x200D
+	variationSelector = 0xFE0F
+	combiningKeycap   = 0x20E3
+	tagCancel         = 0xE007F
+)
+
+// NormalizeReaction validates a reaction body and returns the text to send:
+// the emoji itself, or an empty string for ReactionRemove, which WhatsApp reads as removal.
+// Anything else, such as plain text or several emoji, fails with ErrInvalidReaction
+// because WhatsApp would silently drop it.
+func NormalizeReaction(body string) (string, error) {
+	if body == ReactionRemove {
+		return "", nil
+	}
+	if !isSingleEmoji(body) {
+		return "", ErrInvalidReaction
+	}
+	return body, nil
+}
+
+// isSingleEmoji reports whether a body is exactly one emoji, including flags, keycaps,
+// skin tone variants, tag sequences and ZWJ sequences
+func isSingleEmoji(body string) bool {
+	runes := []rune(body)
+	if len(runes) == 0 {
+		return false
+	}
+
+	// Flags are exactly two regional indicators
+	if isRegionalIndicator(runes[0]) {
+		return len(runes) == 2 && isRegionalIndicator(runes[1])
+	}
+
+	// Keycaps are a digit, # or * followed by the combining keycap
+	if isKeycapBase(runes[0]) {
+		rest := runes[1:]
+		if len(rest) > 0 && rest[0] == variationSelector {
+			rest = rest[1:]
+		}
+		return len(rest) == 1 && rest[0] == combiningKeycap
+	}
+
+	i, ok := emojiElement(runes, 0)
+	for ok && i < len(runes) && runes[i] == zeroWidthJoiner {
+		i, ok = emojiElement(runes, i+1)
+	}
+	return ok && i == len(runes)
+}
+
+// emojiElement consumes one pictograph with its optional modifiers starting at i,
+// returning the index after it
+func emojiElement(runes []rune, i int) (int, bool) {
+	if i >= len(runes) || !isReactionEmojiRune(runes[i]) || isSkinTone(runes[i]) || isRegionalIndicator(runes[i]) {
+		return i, false
+	}
+	i++
+
+	if i < len(runes) && isSkinTone(runes[i]) {
+		i++
+	}
+	if i < len(runes) && runes[i] == variationSelector {
+		i++
+	}
+
+	// Tag sequences (subdivision flags) end with the cancel tag
+	if i < len(runes) && isTag(runes[i]) {
+		for i < len(runes) && isTag(runes[i]) {
+			i++
+		}
+		if i >= len(runes) || runes[i] != tagCancel {
+			return i, false
+		}
+		i++
+	}
+
+	return i, true
+}
+
+// isReactionEmojiRune reports whether a rune can start an emoji, covering the extended
+// pictographic ranges beyond the main blocks matched by isEmojiRune
+func isReactionEmojiRune(r rune) bool {
+	if isEmojiRune(r) {
+		return true
+	}
+	switch {
+	case r >= 0x1F000 && r <= 0x1F2FF: // Mahjong, playing cards, enclosed alphanumerics and ideographs
+		return true
+	case r >= 0x2300 && r <= 0x23FF: // Miscellaneous technical (watch, hourglass, media controls)
+		return true
+	case r >= 0x2B00 && r <= 0x2BFF: // Arrows and shapes (star, large squares and circles)
+		return true
+	case r >= 0x2190 && r <= 0x21FF: // Arrows
+		return true
+	case r >= 0x25A0 && r <= 0x25FF: // Geometric shapes
+		return true
+	}
+	switch r {
+	case 0x00A9, 0x00AE, 0x203C, 0x2049, 0x2122, 0x2139, 0x24C2, 0x3030, 0x303D, 0x3297, 0x3299:
+		return true
+	}
+	return false
+}
+
+// isRegionalIndicator reports whether a rune is a flag letter
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// isKeycapBase reports whether a rune can start a keycap sequence
+func isKeycapBase(r rune) bool {
+	return (r >= '0' && r <= '9') || r == '#' || r == '*'
+}
+
+// isSkinTone reports whether a rune is a Fitzpatrick skin tone modifier
+func isSkinTone(r rune) bool {
+	return r >= 0x1F3FB && r <= 0x1F3FF
+}
+
+// isTag reports whether a rune is a tag character used in subdivision flags
+func isTag(r rune) bool {
+	return r >= 0xE0020 && r <= 0xE007E
+}
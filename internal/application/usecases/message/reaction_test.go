@@ -0,0 +1,52 @@
+package message
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalizeReaction(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+		err  error
+	}{
+		{name: "thumbs up", body: "👍", want: "👍"},
+		{name: "heart with variation selector", body: "❤️", want: "❤️"},
+		{name: "heart without variation selector", body: "❤", want: "❤"},
+		{name: "skin tone", body: "👍🏽", want: "👍🏽"},
+		{name: "zwj family", body: "👨‍👩‍👧", want: "👨‍👩‍👧"},
+		{name: "zwj with skin tone and gender", body: "🏃🏾‍♀️", want: "🏃🏾‍♀️"},
+		{name: "flag", body: "🇧🇷", want: "🇧🇷"},
+		{name: "subdivision flag", body: "🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F", want: "🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F"},
+		{name: "keycap", body: "1️⃣", want: "1️⃣"},
+		{name: "extended pictographic", body: "⭐", want: "⭐"},
+		{name: "recent emoji", body: "🫠", want: "🫠"},
+		{name: "remove sentinel", body: ReactionRemove, want: ""},
+		{name: "empty", body: "", err: ErrInvalidReaction},
+		{name: "two emoji", body: "👍👍", err: ErrInvalidReaction},
+		{name: "two flags", body: "🇧🇷🇺🇸", err: ErrInvalidReaction},
+		{name: "emoji with space", body: "👍 ", err: ErrInvalidReaction},
+		{name: "plain text", body: "ok", err: ErrInvalidReaction},
+		{name: "emoji and text", body: "👍ok", err: ErrInvalidReaction},
+		{name: "digit without keycap", body: "1", err: ErrInvalidReaction},
+		{name: "single regional indicator", body: "🇧", err: ErrInvalidReaction},
+		{name: "lone skin tone", body: "🏽", err: ErrInvalidReaction},
+		{name: "trailing zwj", body: "👨‍", err: ErrInvalidReaction},
+		{name: "unterminated tag sequence", body: "🏴\U000E0067\U000E0062", err: ErrInvalidReaction},
+		{name: "remove in other case", body: "Remove", err: ErrInvalidReaction},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeReaction(tt.body)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("NormalizeReaction(%q) error = %v, want %v", tt.body, err, tt.err)
+			}
+			if got != tt.want {
+				t.Fatalf("NormalizeReaction(%q) = %q, want %q", tt.body, got, tt.want)
+			}
+		})
+	}
+}
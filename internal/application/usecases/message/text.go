@@ -0,0 +1,69 @@
+package message
+
+import (
+	"regexp"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// DefaultMaxTextLength is the text limit of the WhatsApp apps, used when none is configured
+const DefaultMaxTextLength = 65536
+
+// mentionPattern matches "@<phone>" mentions as used by WhatsApp clients
+var mentionPattern = regexp.MustCompile(`@(\d{5,20})\b`)
+
+// ValidateBodyLength checks that a text body is not empty and has at most maxLength characters
+func ValidateBodyLength(body string, maxLength int) error {
+	if body == "" {
+		return ErrEmptyBody
+	}
+	if utf8.RuneCountInString(body) > maxLength {
+		return ErrBodyTooLong
+	}
+	return nil
+}
+
+// ExtractMentions returns the phone numbers mentioned in a body, without duplicates
+func ExtractMentions(body string) []string {
+	var mentions []string
+	seen := make(map[string]bool)
+
+	for _, match := range mentionPattern.FindAllStringSubmatch(body, -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			mentions = append(mentions, match[1])
+		}
+	}
+
+	return mentions
+}
+
+// countUTF16Units returns the length of a body in UTF-16 code units, as counted by WhatsApp clients
+func countUTF16Units(body string) int {
+	return len(utf16.Encode([]rune(body)))
+}
+
+// countEmoji returns an approximate count of emoji in a body
+func countEmoji(body string) int {
+	count := 0
+	for _, r := range body {
+		if isEmojiRune(r) {
+			count++
+		}
+	}
+	return count
+}
+
+// isEmojiRune reports whether a rune belongs to the main emoji blocks
+func isEmojiRune(r rune) bool {
+	switch {
+	case r >= 0x1F300 && r <= 0x1FAFF: // Symbols, pictographs, emoticons, transport, supplemental
+		return true
+	case r >= 0x2600 && r <= 0x27BF: // Miscellaneous symbols and dingbats
+		return true
+	case r >= 0x1F1E6 && r <= 0x1F1FF: // Regional indicators (flags)
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,54 @@
+package message
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateBodyLength(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		maxLength int
+		wantErr   error
+	}{
+		{name: "empty", body: "", maxLength: 10, wantErr: ErrEmptyBody},
+		{name: "under limit", body: "hello", maxLength: 10},
+		{name: "at limit", body: strings.Repeat("a", 10), maxLength: 10},
+		{name: "over limit", body: strings.Repeat("a", 11), maxLength: 10, wantErr: ErrBodyTooLong},
+		{name: "multibyte counted as characters", body: strings.Repeat("é", 10), maxLength: 10},
+		{name: "emoji counted as characters", body: strings.Repeat("😀", 11), maxLength: 10, wantErr: ErrBodyTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateBodyLength(tt.body, tt.maxLength); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidateBodyLength() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExtractMentions(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{name: "none", body: "hello there"},
+		{name: "single", body: "hi @5511999999999!", want: []string{"5511999999999"}},
+		{name: "duplicates removed", body: "@5511999999999 and @5511999999999 and @5511888888888", want: []string{"5511999999999", "5511888888888"}},
+		{name: "too short", body: "room @1234"},
+		{name: "email ignored", body: "mail me at john@example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractMentions(tt.body)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Fatalf("ExtractMentions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package profile
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/services"
+	"wazmeow/pkg/logger"
+)
+
+// GetProfilePictureUseCase handles retrieving the account's own profile picture
+type GetProfilePictureUseCase struct {
+	whatsappSvc services.WhatsAppService
+}
+
+// NewGetProfilePictureUseCase creates a new GetProfilePictureUseCase
+func NewGetProfilePictureUseCase(whatsappSvc services.WhatsAppService) *GetProfilePictureUseCase {
+	return &GetProfilePictureUseCase{
+		whatsappSvc: whatsappSvc,
+	}
+}
+
+// Execute returns the current profile picture, refreshing the cached picture ID
+func (uc *GetProfilePictureUseCase) Execute(ctx context.Context, sessionID string) (*services.ProfilePicture, error) {
+	picture, err := uc.whatsappSvc.GetProfilePicture(ctx, sessionID)
+	if err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to get profile picture")
+		return nil, err
+	}
+
+	return picture, nil
+}
@@ -0,0 +1,62 @@
+package profile
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/services"
+	"wazmeow/pkg/logger"
+)
+
+// GetPushNameUseCase handles retrieving the account push name
+type GetPushNameUseCase struct {
+	whatsappSvc services.WhatsAppService
+}
+
+// NewGetPushNameUseCase creates a new GetPushNameUseCase
+func NewGetPushNameUseCase(whatsappSvc services.WhatsAppService) *GetPushNameUseCase {
+	return &GetPushNameUseCase{
+		whatsappSvc: whatsappSvc,
+	}
+}
+
+// Execute returns the push name and whether it diverges from the session name
+func (uc *GetPushNameUseCase) Execute(ctx context.Context, sessionID string) (*services.PushNameInfo, error) {
+	info, err := uc.whatsappSvc.GetPushName(ctx, sessionID)
+	if err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to get push name")
+		return nil, err
+	}
+
+	if info.Diverged {
+		logger.Warn().
+			Str("sessionId", sessionID).
+			Str("pushName", info.PushName).
+			Str("sessionName", info.SessionName).
+			Msg("Push name diverges from session name")
+	}
+
+	return info, nil
+}
+
+// SyncPushNameUseCase handles reconciling the account push name with the session name
+type SyncPushNameUseCase struct {
+	whatsappSvc services.WhatsAppService
+}
+
+// NewSyncPushNameUseCase creates a new SyncPushNameUseCase
+func NewSyncPushNameUseCase(whatsappSvc services.WhatsAppService) *SyncPushNameUseCase {
+	return &SyncPushNameUseCase{
+		whatsappSvc: whatsappSvc,
+	}
+}
+
+// Execute sets the account push name to the session name when they differ
+func (uc *SyncPushNameUseCase) Execute(ctx context.Context, sessionID string) (*services.PushNameInfo, error) {
+	info, err := uc.whatsappSvc.SyncPushName(ctx, sessionID)
+	if err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to sync push name")
+		return nil, err
+	}
+
+	return info, nil
+}
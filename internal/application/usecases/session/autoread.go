@@ -0,0 +1,49 @@
+package session
+
+import (
+	"context"
+	"errors"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/internal/domain/repositories"
+	"wazmeow/internal/domain/services"
+	"wazmeow/pkg/logger"
+)
+
+// SetAutoMarkReadUseCase handles toggling automatic read receipts for a session
+type SetAutoMarkReadUseCase struct {
+	sessionRepo repositories.SessionRepository
+	whatsappSvc services.WhatsAppService
+}
+
+// NewSetAutoMarkReadUseCase creates a new SetAutoMarkReadUseCase
+func NewSetAutoMarkReadUseCase(sessionRepo repositories.SessionRepository, whatsappSvc services.WhatsAppService) *SetAutoMarkReadUseCase {
+	return &SetAutoMarkReadUseCase{
+		sessionRepo: sessionRepo,
+		whatsappSvc: whatsappSvc,
+	}
+}
+
+// Execute enables or disables automatic read receipts for incoming messages
+func (uc *SetAutoMarkReadUseCase) Execute(ctx context.Context, sessionID string, req dto.SetAutoMarkReadRequest) (*dto.SessionResponse, error) {
+	session, err := uc.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to get session")
+		return nil, err
+	}
+	if session == nil {
+		return nil, errors.New("session not found")
+	}
+
+	session.SetAutoMarkRead(req.Enabled)
+	if err := uc.sessionRepo.Update(ctx, session); err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to update auto mark read")
+		return nil, err
+	}
+	uc.whatsappSvc.SetAutoMarkRead(sessionID, req.Enabled)
+
+	logger.Info().Str("sessionId", sessionID).Bool("enabled", req.Enabled).Msg("Auto mark read updated")
+
+	response := dto.ToSessionResponse(session)
+	return &response, nil
+}
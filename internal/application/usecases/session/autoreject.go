@@ -0,0 +1,49 @@
+package session
+
+import (
+	"context"
+	"errors"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/internal/domain/repositories"
+	"wazmeow/internal/domain/services"
+	"wazmeow/pkg/logger"
+)
+
+// SetAutoRejectCallsUseCase handles toggling automatic call rejection for a session
+type SetAutoRejectCallsUseCase struct {
+	sessionRepo repositories.SessionRepository
+	whatsappSvc services.WhatsAppService
+}
+
+// NewSetAutoRejectCallsUseCase creates a new SetAutoRejectCallsUseCase
+func NewSetAutoRejectCallsUseCase(sessionRepo repositories.SessionRepository, whatsappSvc services.WhatsAppService) *SetAutoRejectCallsUseCase {
+	return &SetAutoRejectCallsUseCase{
+		sessionRepo: sessionRepo,
+		whatsappSvc: whatsappSvc,
+	}
+}
+
+// Execute enables or disables automatic rejection of incoming calls
+func (uc *SetAutoRejectCallsUseCase) Execute(ctx context.Context, sessionID string, req dto.SetAutoRejectCallsRequest) (*dto.SessionResponse, error) {
+	session, err := uc.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to get session")
+		return nil, err
+	}
+	if session == nil {
+		return nil, errors.New("session not found")
+	}
+
+	session.SetAutoRejectCalls(req.Enabled, req.Message)
+	if err := uc.sessionRepo.Update(ctx, session); err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to update auto reject calls")
+		return nil, err
+	}
+	uc.whatsappSvc.SetAutoReject(sessionID, session.AutoRejectCalls, session.AutoRejectMessage)
+
+	logger.Info().Str("sessionId", sessionID).Bool("enabled", req.Enabled).Msg("Auto reject calls updated")
+
+	response := dto.ToSessionResponse(session)
+	return &response, nil
+}
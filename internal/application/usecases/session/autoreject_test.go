@@ -0,0 +1,43 @@
+package session
+
+import (
+	"context"
+	"testing"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/internal/domain/entities"
+)
+
+func TestSetAutoRejectCallsRefreshesCache(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         dto.SetAutoRejectCallsRequest
+		wantCached  bool
+		wantMessage string
+	}{
+		{name: "enable with message", req: dto.SetAutoRejectCallsRequest{Enabled: true, Message: "busy"}, wantCached: true, wantMessage: "busy"},
+		{name: "disable", req: dto.SetAutoRejectCallsRequest{Enabled: false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := entities.NewSession("calls")
+			session.SetAutoRejectCalls(!tt.req.Enabled, "previous")
+			repo := newFakeSessionRepo(session)
+			svc := newFakeWhatsAppService()
+			svc.SetAutoReject(session.ID, session.AutoRejectCalls, session.AutoRejectMessage)
+
+			if _, err := NewSetAutoRejectCallsUseCase(repo, svc).Execute(context.Background(), session.ID, tt.req); err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+
+			message, cached := svc.autoReject[session.ID]
+			if cached != tt.wantCached || message != tt.wantMessage {
+				t.Fatalf("cached settings = (%v, %q), want (%v, %q)", cached, message, tt.wantCached, tt.wantMessage)
+			}
+			if stored, _ := repo.GetByID(context.Background(), session.ID); stored.AutoRejectCalls != tt.req.Enabled {
+				t.Fatalf("stored AutoRejectCalls = %v, want %v", stored.AutoRejectCalls, tt.req.Enabled)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/internal/domain/entities"
+	"wazmeow/internal/domain/repositories"
+	"wazmeow/internal/domain/services"
+	"wazmeow/pkg/logger"
+)
+
+// redactedProxyCredentials identifies the proxy credentials in SessionConfig.RedactedFields
+const redactedProxyCredentials = "proxyConfig.proxyURL.credentials"
+
+// ExportSessionConfigUseCase handles exporting the configuration of a session
+type ExportSessionConfigUseCase struct {
+	sessionRepo repositories.SessionRepository
+}
+
+// NewExportSessionConfigUseCase creates a new ExportSessionConfigUseCase
+func NewExportSessionConfigUseCase(sessionRepo repositories.SessionRepository) *ExportSessionConfigUseCase {
+	return &ExportSessionConfigUseCase{
+		sessionRepo: sessionRepo,
+	}
+}
+
+// Execute returns the session configuration with secrets removed
+func (uc *ExportSessionConfigUseCase) Execute(ctx context.Context, sessionID string) (*dto.SessionConfig, error) {
+	session, err := uc.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to get session")
+		return nil, err
+	}
+	if session == nil {
+		return nil, errors.New("session not found")
+	}
+
+	config := &dto.SessionConfig{
+		WebhookURL:        session.WebhookURL,
+		Events:            session.Events,
+		AutoMarkRead:      session.AutoMarkRead,
+		AutoRejectCalls:   session.AutoRejectCalls,
+		AutoRejectMessage: session.AutoRejectMessage,
+	}
+
+	if session.ProxyConfig != nil {
+		proxyURL, redacted := redactProxyURL(session.ProxyConfig.ProxyURL)
+		config.ProxyConfig = &entities.ProxyConfig{
+			Enabled:  session.ProxyConfig.Enabled,
+			ProxyURL: proxyURL,
+		}
+		if redacted {
+			config.RedactedFields = append(config.RedactedFields, redactedProxyCredentials)
+		}
+	}
+
+	return config, nil
+}
+
+// ImportSessionConfigUseCase handles applying an exported configuration to a session
+type ImportSessionConfigUseCase struct {
+	sessionRepo repositories.SessionRepository
+	whatsappSvc services.WhatsAppService
+}
+
+// NewImportSessionConfigUseCase creates a new ImportSessionConfigUseCase
+func NewImportSessionConfigUseCase(sessionRepo repositories.SessionRepository, whatsappSvc services.WhatsAppService) *ImportSessionConfigUseCase {
+	return &ImportSessionConfigUseCase{
+		sessionRepo: sessionRepo,
+		whatsappSvc: whatsappSvc,
+	}
+}
+
+// Execute replaces the session configuration with the imported one
+func (uc *ImportSessionConfigUseCase) Execute(ctx context.Context, sessionID string, config dto.SessionConfig) (*dto.SessionResponse, error) {
+	session, err := uc.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to get session")
+		return nil, err
+	}
+	if session == nil {
+		return nil, errors.New("session not found")
+	}
+
+	if err := entities.ValidateWebhookURL(config.WebhookURL); err != nil {
+		return nil, err
+	}
+
+	var proxyConfig *entities.ProxyConfig
+	if config.ProxyConfig != nil {
+		proxy := *config.ProxyConfig
+		proxyConfig = &proxy
+		if err := proxyConfig.Validate(); err != nil {
+			return nil, err
+		}
+		if slices.Contains(config.RedactedFields, redactedProxyCredentials) {
+			proxyConfig.ProxyURL = keepProxyCredentials(sessionID, proxyConfig.ProxyURL, session.ProxyConfig)
+		}
+	}
+
+	session.SetWebhook(config.WebhookURL, config.Events)
+	session.SetProxy(proxyConfig)
+	session.SetAutoMarkRead(config.AutoMarkRead)
+	session.SetAutoRejectCalls(config.AutoRejectCalls, config.AutoRejectMessage)
+
+	if err := uc.sessionRepo.Update(ctx, session); err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to import session config")
+		return nil, err
+	}
+
+	uc.whatsappSvc.SetAutoMarkRead(sessionID, config.AutoMarkRead)
+	uc.whatsappSvc.SetAutoReject(sessionID, session.AutoRejectCalls, session.AutoRejectMessage)
+
+	// Apply the proxy to the running client, as the set proxy endpoint does
+	if proxyConfig != nil {
+		if err := uc.whatsappSvc.SetProxy(sessionID, proxyConfig); err != nil {
+			logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to apply imported proxy")
+			return nil, err
+		}
+	}
+
+	logger.Info().Str("sessionId", sessionID).Msg("Session config imported")
+
+	response := dto.ToSessionResponse(session)
+	return &response, nil
+}
+
+// keepProxyCredentials restores the credentials stripped on export from the session's current
+// proxy, only when it points to the same host so secrets never move to another proxy
+func keepProxyCredentials(sessionID, proxyURL string, current *entities.ProxyConfig) string {
+	imported, err := url.Parse(proxyURL)
+	if err != nil || imported.User != nil {
+		return proxyURL
+	}
+
+	if current != nil {
+		if existing, err := url.Parse(current.ProxyURL); err == nil && existing.User != nil && existing.Host == imported.Host {
+			imported.User = existing.User
+			return imported.String()
+		}
+	}
+
+	logger.Warn().Str("sessionId", sessionID).Msg("Imported proxy credentials were redacted, set them again")
+	return proxyURL
+}
+
+// redactProxyURL removes credentials from a proxy URL, reporting whether anything was removed
+func redactProxyURL(proxyURL string) (string, bool) {
+	if proxyURL == "" {
+		return "", false
+	}
+
+	parsed, err := url.Parse(proxyURL)
+	if err != nil {
+		// Unparsable URLs may embed credentials anywhere, so drop them entirely
+		return "", true
+	}
+	if parsed.User == nil {
+		return proxyURL, false
+	}
+
+	parsed.User = nil
+	return parsed.String(), true
+}
@@ -2,6 +2,8 @@ package session
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"wazmeow/internal/application/dto"
 	"wazmeow/internal/domain/entities"
@@ -9,15 +11,24 @@ import (
 	"wazmeow/pkg/logger"
 )
 
+// maxNameSuffix is the highest numeric suffix tried when auto-suffixing duplicate names
+const maxNameSuffix = 100
+
+// maxCreateAttempts bounds the retries when a concurrent create takes the resolved name
+const maxCreateAttempts = 3
+
 // CreateSessionUseCase handles session creation
 type CreateSessionUseCase struct {
-	sessionRepo repositories.SessionRepository
+	sessionRepo    repositories.SessionRepository
+	autoSuffixName bool
 }
 
-// NewCreateSessionUseCase creates a new CreateSessionUseCase
-func NewCreateSessionUseCase(sessionRepo repositories.SessionRepository) *CreateSessionUseCase {
+// NewCreateSessionUseCase creates a new CreateSessionUseCase.
+// When autoSuffixName is set, duplicate names get a numeric suffix instead of being rejected.
+func NewCreateSessionUseCase(sessionRepo repositories.SessionRepository, autoSuffixName bool) *CreateSessionUseCase {
 	return &CreateSessionUseCase{
-		sessionRepo: sessionRepo,
+		sessionRepo:    sessionRepo,
+		autoSuffixName: autoSuffixName,
 	}
 }
 
@@ -29,6 +40,13 @@ func (uc *CreateSessionUseCase) Execute(ctx context.Context, req dto.CreateSessi
 	session := entities.NewSession(req.Name)
 
 	// Set optional fields
+	if req.ClaimedPhone != "" {
+		session.SetClaimedPhone(req.ClaimedPhone)
+		if session.ClaimedPhone == "" {
+			return nil, errors.New("claimed phone must contain digits")
+		}
+	}
+
 	if req.WebhookURL != "" || req.Events != "" {
 		session.SetWebhook(req.WebhookURL, req.Events)
 	}
@@ -37,15 +55,29 @@ func (uc *CreateSessionUseCase) Execute(ctx context.Context, req dto.CreateSessi
 		session.SetProxy(req.ProxyConfig)
 	}
 
+	if req.Persistent != nil {
+		session.Persistent = *req.Persistent
+	}
+
+	if req.AutoMarkRead {
+		session.SetAutoMarkRead(true)
+	}
+
+	if req.AutoReject != nil {
+		session.SetAutoRejectCalls(req.AutoReject.Enabled, req.AutoReject.Message)
+	}
+
 	// Validate session
 	if err := session.Validate(); err != nil {
 		logger.Error().Err(err).Msg("Session validation failed")
 		return nil, err
 	}
 
-	// Save to repository
-	if err := uc.sessionRepo.Create(ctx, session); err != nil {
-		logger.Error().Err(err).Str("sessionId", session.ID).Msg("Failed to create session")
+	// Save to repository under a free name
+	if err := uc.create(ctx, session); err != nil {
+		if !errors.Is(err, entities.ErrSessionNameExists) {
+			logger.Error().Err(err).Str("sessionId", session.ID).Msg("Failed to create session")
+		}
 		return nil, err
 	}
 
@@ -55,3 +87,53 @@ func (uc *CreateSessionUseCase) Execute(ctx context.Context, req dto.CreateSessi
 	response := dto.ToSessionResponse(session)
 	return &response, nil
 }
+
+// create stores the session under a free name. The name check and the insert are separate,
+// so when auto-suffixing a name taken concurrently is retried with the next free suffix.
+func (uc *CreateSessionUseCase) create(ctx context.Context, session *entities.Session) error {
+	requested := session.Name
+
+	for attempt := 1; ; attempt++ {
+		name, err := uc.resolveName(ctx, requested)
+		if err != nil {
+			return err
+		}
+		session.Name = name
+
+		err = uc.sessionRepo.Create(ctx, session)
+		if !errors.Is(err, entities.ErrSessionNameExists) || !uc.autoSuffixName || attempt >= maxCreateAttempts {
+			return err
+		}
+
+		logger.Warn().Str("name", name).Msg("Session name taken concurrently, retrying with the next suffix")
+	}
+}
+
+// resolveName returns the name to use, rejecting or suffixing names already in use
+func (uc *CreateSessionUseCase) resolveName(ctx context.Context, name string) (string, error) {
+	if name == "" {
+		return name, nil
+	}
+
+	for suffix := 1; suffix <= maxNameSuffix; suffix++ {
+		candidate := name
+		if suffix > 1 {
+			candidate = fmt.Sprintf("%s-%d", name, suffix)
+		}
+
+		existing, err := uc.sessionRepo.GetByName(ctx, candidate)
+		if err != nil {
+			logger.Error().Err(err).Str("name", candidate).Msg("Failed to check session name")
+			return "", err
+		}
+		if existing == nil {
+			return candidate, nil
+		}
+		if !uc.autoSuffixName {
+			break
+		}
+	}
+
+	logger.Warn().Str("name", name).Msg("Session name already exists")
+	return "", entities.ErrSessionNameExists
+}
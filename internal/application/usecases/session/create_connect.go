@@ -0,0 +1,115 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/internal/domain/repositories"
+	"wazmeow/internal/domain/services"
+	"wazmeow/pkg/logger"
+)
+
+const (
+	// firstQRWaitTimeout is how long to wait for the first QR code before reporting it as pending
+	firstQRWaitTimeout = 3 * time.Second
+	// firstQRPollInterval is how often the stored QR code is checked while waiting
+	firstQRPollInterval = 200 * time.Millisecond
+)
+
+// CreateAndConnectSessionUseCase handles creating a session and starting its connection in one step
+type CreateAndConnectSessionUseCase struct {
+	createUseCase  *CreateSessionUseCase
+	connectUseCase *ConnectSessionUseCase
+	sessionRepo    repositories.SessionRepository
+	whatsappSvc    services.WhatsAppService
+}
+
+// NewCreateAndConnectSessionUseCase creates a new CreateAndConnectSessionUseCase
+func NewCreateAndConnectSessionUseCase(
+	createUseCase *CreateSessionUseCase,
+	connectUseCase *ConnectSessionUseCase,
+	sessionRepo repositories.SessionRepository,
+	whatsappSvc services.WhatsAppService,
+) *CreateAndConnectSessionUseCase {
+	return &CreateAndConnectSessionUseCase{
+		createUseCase:  createUseCase,
+		connectUseCase: connectUseCase,
+		sessionRepo:    sessionRepo,
+		whatsappSvc:    whatsappSvc,
+	}
+}
+
+// Execute creates the session and starts the connect flow, deleting the session if connect fails
+func (uc *CreateAndConnectSessionUseCase) Execute(ctx context.Context, req dto.CreateSessionRequest) (*dto.CreateAndConnectSessionResponse, error) {
+	created, err := uc.createUseCase.Execute(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.connectUseCase.Execute(ctx, created.ID, dto.ConnectSessionRequest{}); err != nil {
+		if rollbackErr := uc.rollback(ctx, created.ID); rollbackErr != nil {
+			return nil, fmt.Errorf("failed to connect session, session %s kept (%v): %w", created.ID, rollbackErr, err)
+		}
+		return nil, fmt.Errorf("failed to connect session, creation rolled back: %w", err)
+	}
+
+	response := &dto.CreateAndConnectSessionResponse{
+		Session: *created,
+		QRCode:  uc.waitForQRCode(ctx, created.ID),
+	}
+	response.QRPending = response.QRCode == ""
+
+	logger.Info().
+		Str("sessionId", created.ID).
+		Bool("qrPending", response.QRPending).
+		Msg("Session created and connection initiated")
+
+	return response, nil
+}
+
+// rollback removes a session whose connect setup failed. The session is kept when its
+// client cannot be stopped, so that a running client never outlives its database row.
+func (uc *CreateAndConnectSessionUseCase) rollback(ctx context.Context, sessionID string) error {
+	if uc.whatsappSvc.IsConnected(sessionID) || uc.whatsappSvc.IsLoggedIn(sessionID) {
+		logger.Warn().Str("sessionId", sessionID).Msg("Session connected despite connect error, skipping rollback")
+		return errors.New("session connected despite connect error")
+	}
+
+	// The client may already exist when connect failed part-way
+	if err := uc.whatsappSvc.StopSession(ctx, sessionID); err != nil && !errors.Is(err, services.ErrSessionNotRunning) {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to stop session, skipping rollback")
+		return fmt.Errorf("failed to stop session: %w", err)
+	}
+
+	if err := uc.sessionRepo.Delete(ctx, sessionID); err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to roll back created session")
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	logger.Info().Str("sessionId", sessionID).Msg("Created session rolled back")
+	return nil
+}
+
+// waitForQRCode waits briefly for the first QR code, returning empty if it is not ready yet
+func (uc *CreateAndConnectSessionUseCase) waitForQRCode(ctx context.Context, sessionID string) string {
+	ctx, cancel := context.WithTimeout(ctx, firstQRWaitTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(firstQRPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if qrCode, err := uc.whatsappSvc.GetQRCode(ctx, sessionID); err == nil && qrCode != "" {
+			return qrCode
+		}
+
+		select {
+		case <-ctx.Done():
+			return ""
+		case <-ticker.C:
+		}
+	}
+}
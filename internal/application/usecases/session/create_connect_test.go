@@ -0,0 +1,88 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/internal/domain/entities"
+	"wazmeow/internal/domain/services"
+)
+
+func TestCreateAndConnectSession(t *testing.T) {
+	errStart := errors.New("store unavailable")
+	errStop := errors.New("client busy")
+
+	tests := []struct {
+		name          string
+		existing      string
+		qrCode        string
+		startErr      error
+		stopErr       error
+		connected     bool
+		wantErr       error
+		wantStarted   bool
+		wantQRPending bool
+		wantKept      bool
+	}{
+		{name: "first QR returned", qrCode: "qr-png", wantStarted: true, wantKept: true},
+		{name: "QR pending", wantStarted: true, wantQRPending: true, wantKept: true},
+		{name: "create fails", existing: "sales", wantErr: entities.ErrSessionNameExists},
+		{name: "connect fails rolls back", startErr: errStart, wantErr: errStart, wantStarted: true},
+		{name: "rollback with no client running", startErr: errStart, stopErr: services.ErrSessionNotRunning, wantErr: errStart, wantStarted: true},
+		{name: "stop fails keeps session", startErr: errStart, stopErr: errStop, wantErr: errStart, wantStarted: true, wantKept: true},
+		{name: "connected despite error keeps session", startErr: errStart, connected: true, wantErr: errStart, wantStarted: true, wantKept: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeSessionRepo()
+			if tt.existing != "" {
+				existing := entities.NewSession(tt.existing)
+				repo.sessions[existing.ID] = existing
+			}
+			svc := newFakeWhatsAppService()
+			svc.qrCode = tt.qrCode
+			svc.startErr = tt.startErr
+			svc.stopErr = tt.stopErr
+			svc.connected = tt.connected
+
+			uc := NewCreateAndConnectSessionUseCase(
+				NewCreateSessionUseCase(repo, false),
+				NewConnectSessionUseCase(repo, svc),
+				repo,
+				svc,
+			)
+
+			// Bounds the wait for the first QR code
+			ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+			defer cancel()
+
+			response, err := uc.Execute(ctx, dto.CreateSessionRequest{Name: "sales"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Execute() error = %v, want %v", err, tt.wantErr)
+			}
+			if started := len(svc.started) == 1; started != tt.wantStarted {
+				t.Fatalf("started %v, want started %v", svc.started, tt.wantStarted)
+			}
+
+			if tt.wantErr == nil {
+				if response.QRCode != tt.qrCode || response.QRPending != tt.wantQRPending {
+					t.Fatalf("response = %+v, want QR %q pending %v", response, tt.qrCode, tt.wantQRPending)
+				}
+				if svc.started[0] != response.Session.ID {
+					t.Fatalf("started %v, want session %s", svc.started, response.Session.ID)
+				}
+			}
+
+			if tt.existing != "" {
+				return
+			}
+			if kept := repo.names()["sales"]; kept != tt.wantKept {
+				t.Fatalf("session kept = %v, want %v (deleted %v)", kept, tt.wantKept, repo.deleted)
+			}
+		})
+	}
+}
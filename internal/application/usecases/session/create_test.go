@@ -0,0 +1,66 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/internal/domain/entities"
+)
+
+func TestCreateSessionNames(t *testing.T) {
+	tests := []struct {
+		name         string
+		existing     []string
+		takeOnCreate []string
+		autoSuffix   bool
+		request      string
+		want         string
+		wantErr      error
+	}{
+		{name: "free name", request: "sales", want: "sales"},
+		{name: "duplicate rejected", existing: []string{"sales"}, request: "sales", wantErr: entities.ErrSessionNameExists},
+		{name: "duplicate suffixed", existing: []string{"sales"}, autoSuffix: true, request: "sales", want: "sales-2"},
+		{name: "next free suffix", existing: []string{"sales", "sales-2", "sales-3"}, autoSuffix: true, request: "sales", want: "sales-4"},
+		{name: "concurrent create rejected", takeOnCreate: []string{"sales"}, request: "sales", wantErr: entities.ErrSessionNameExists},
+		{name: "concurrent create retried with suffix", takeOnCreate: []string{"sales"}, autoSuffix: true, request: "sales", want: "sales-2"},
+		{
+			name:         "retries are bounded",
+			takeOnCreate: []string{"sales", "sales-2", "sales-3"},
+			autoSuffix:   true,
+			request:      "sales",
+			wantErr:      entities.ErrSessionNameExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeSessionRepo()
+			for _, name := range tt.existing {
+				existing := entities.NewSession(name)
+				repo.sessions[existing.ID] = existing
+			}
+			repo.takeOnCreate = tt.takeOnCreate
+
+			uc := NewCreateSessionUseCase(repo, tt.autoSuffix)
+			response, err := uc.Execute(context.Background(), dto.CreateSessionRequest{Name: tt.request})
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if response.Name != tt.want {
+				t.Fatalf("Name = %q, want %q", response.Name, tt.want)
+			}
+			if stored, _ := repo.GetByID(context.Background(), response.ID); stored == nil || stored.Name != tt.want {
+				t.Fatalf("stored session = %+v, want name %q", stored, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package session
+
+import (
+	"context"
+	"sync"
+
+	"wazmeow/internal/domain/entities"
+	"wazmeow/internal/domain/services"
+)
+
+// fakeSessionRepo is an in-memory session repository that enforces unique names like the database index
+type fakeSessionRepo struct {
+	mu       sync.Mutex
+	sessions map[string]*entities.Session
+	// takeOnCreate simulates concurrent creates: each listed name is taken by another
+	// session right before the next insert under that name
+	takeOnCreate []string
+	deleted      []string
+}
+
+func newFakeSessionRepo(sessions ...*entities.Session) *fakeSessionRepo {
+	repo := &fakeSessionRepo{sessions: make(map[string]*entities.Session)}
+	for _, session := range sessions {
+		repo.sessions[session.ID] = session
+	}
+	return repo
+}
+
+func (r *fakeSessionRepo) Create(_ context.Context, session *entities.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, name := range r.takeOnCreate {
+		if name == session.Name {
+			r.takeOnCreate = append(r.takeOnCreate[:i], r.takeOnCreate[i+1:]...)
+			concurrent := entities.NewSession(name)
+			r.sessions[concurrent.ID] = concurrent
+			break
+		}
+	}
+
+	for _, existing := range r.sessions {
+		if existing.Name == session.Name {
+			return entities.ErrSessionNameExists
+		}
+	}
+	copied := *session
+	r.sessions[session.ID] = &copied
+	return nil
+}
+
+func (r *fakeSessionRepo) GetByID(_ context.Context, id string) (*entities.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	session, ok := r.sessions[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *session
+	return &copied, nil
+}
+
+func (r *fakeSessionRepo) GetByName(_ context.Context, name string) (*entities.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, session := range r.sessions {
+		if session.Name == name {
+			copied := *session
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeSessionRepo) GetByDeviceJID(context.Context, string) (*entities.Session, error) {
+	return nil, nil
+}
+
+func (r *fakeSessionRepo) GetAll(context.Context) ([]*entities.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	sessions := make([]*entities.Session, 0, len(r.sessions))
+	for _, session := range r.sessions {
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+func (r *fakeSessionRepo) GetConnectedSessions(context.Context) ([]*entities.Session, error) {
+	return nil, nil
+}
+
+func (r *fakeSessionRepo) Update(_ context.Context, session *entities.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *session
+	r.sessions[session.ID] = &copied
+	return nil
+}
+
+func (r *fakeSessionRepo) Delete(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.sessions, id)
+	r.deleted = append(r.deleted, id)
+	return nil
+}
+
+func (r *fakeSessionRepo) UpdateStatus(_ context.Context, id string, status entities.SessionStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if session, ok := r.sessions[id]; ok {
+		session.Status = status
+	}
+	return nil
+}
+
+// names returns the names of the stored sessions
+func (r *fakeSessionRepo) names() map[string]bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	names := make(map[string]bool, len(r.sessions))
+	for _, session := range r.sessions {
+		names[session.Name] = true
+	}
+	return names
+}
+
+// fakeWhatsAppService implements the methods of services.WhatsAppService used by the session
+// use cases; calling any other method panics through the nil embedded interface
+type fakeWhatsAppService struct {
+	services.WhatsAppService
+
+	mu         sync.Mutex
+	started    []string
+	stopped    []string
+	startErr   error
+	stopErr    error
+	connected  bool
+	qrCode     string
+	proxies    map[string]*entities.ProxyConfig
+	autoRead   map[string]bool
+	autoReject map[string]string // sessionID -> message, only while enabled
+}
+
+func newFakeWhatsAppService() *fakeWhatsAppService {
+	return &fakeWhatsAppService{
+		proxies:    make(map[string]*entities.ProxyConfig),
+		autoRead:   make(map[string]bool),
+		autoReject: make(map[string]string),
+	}
+}
+
+func (s *fakeWhatsAppService) StartSession(_ context.Context, sessionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.started = append(s.started, sessionID)
+	return s.startErr
+}
+
+func (s *fakeWhatsAppService) StopSession(_ context.Context, sessionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stopped = append(s.stopped, sessionID)
+	return s.stopErr
+}
+
+func (s *fakeWhatsAppService) GetQRCode(context.Context, string) (string, error) {
+	return s.qrCode, nil
+}
+
+func (s *fakeWhatsAppService) IsConnected(string) bool {
+	return s.connected
+}
+
+func (s *fakeWhatsAppService) IsLoggedIn(string) bool {
+	return false
+}
+
+func (s *fakeWhatsAppService) SetProxy(sessionID string, config *entities.ProxyConfig) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.proxies[sessionID] = config
+	return nil
+}
+
+func (s *fakeWhatsAppService) SetAutoMarkRead(sessionID string, enabled bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.autoRead[sessionID] = enabled
+}
+
+func (s *fakeWhatsAppService) SetAutoReject(sessionID string, enabled bool, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !enabled {
+		delete(s.autoReject, sessionID)
+		return
+	}
+	s.autoReject[sessionID] = message
+}
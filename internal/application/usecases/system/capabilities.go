@@ -0,0 +1,66 @@
+package system
+
+import (
+	"context"
+	"runtime/debug"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/internal/config"
+)
+
+// whatsmeowModule is the module path used to look up the whatsmeow version in the build info
+const whatsmeowModule = "go.mau.fi/whatsmeow"
+
+// GetCapabilitiesUseCase handles reporting the enabled features of this deployment
+type GetCapabilitiesUseCase struct {
+	config   *config.Config
+	database string
+}
+
+// NewGetCapabilitiesUseCase creates a new GetCapabilitiesUseCase.
+// database is the dialect name of the connected database.
+func NewGetCapabilitiesUseCase(cfg *config.Config, database string) *GetCapabilitiesUseCase {
+	return &GetCapabilitiesUseCase{
+		config:   cfg,
+		database: database,
+	}
+}
+
+// Execute returns the optional features, limits and versions from the loaded config and build info
+func (uc *GetCapabilitiesUseCase) Execute(ctx context.Context) *dto.CapabilitiesResponse {
+	response := &dto.CapabilitiesResponse{
+		APIVersion:       config.BuildVersion(),
+		WhatsmeowVersion: "unknown",
+		GoVersion:        "unknown",
+		Database:         uc.database,
+		Features: map[string]bool{
+			"autoReconnect":     uc.config.WhatsApp.MaxReconnectAttempts > 0,
+			"eventHistory":      uc.config.WhatsApp.EventHistorySize > 0,
+			"qrTerminalOutput":  uc.config.WhatsApp.QRTerminalOutput,
+			"sessionNameSuffix": uc.config.WhatsApp.SessionNameSuffix,
+			"warmup":            uc.config.WhatsApp.WarmupTimeout > 0,
+			"tls":               uc.config.Server.SSLCertFile != "" && uc.config.Server.SSLKeyFile != "",
+			// Not implemented in this server yet; reported so clients don't have to guess
+			"messagePersistence": false,
+			"mediaStorage":       false,
+			"webhookBatching":    false,
+		},
+		Limits: map[string]int{
+			"maxSessions":       uc.config.WhatsApp.MaxSessions,
+			"eventHistorySize":  uc.config.WhatsApp.EventHistorySize,
+			"eventHistoryBytes": uc.config.WhatsApp.EventHistoryBytes,
+		},
+	}
+
+	if info, ok := debug.ReadBuildInfo(); ok {
+		response.GoVersion = info.GoVersion
+		for _, dep := range info.Deps {
+			if dep.Path == whatsmeowModule {
+				response.WhatsmeowVersion = dep.Version
+				break
+			}
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,91 @@
+package system
+
+import (
+	"context"
+	"maps"
+	"testing"
+	"time"
+
+	"wazmeow/internal/config"
+)
+
+func TestCapabilitiesMatchConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   func(*config.Config)
+		database string
+		want     map[string]bool
+	}{
+		{
+			name:     "everything off",
+			config:   func(*config.Config) {},
+			database: "pg",
+			want:     map[string]bool{},
+		},
+		{
+			name: "everything on",
+			config: func(cfg *config.Config) {
+				cfg.WhatsApp.MaxReconnectAttempts = 5
+				cfg.WhatsApp.EventHistorySize = 100
+				cfg.WhatsApp.QRTerminalOutput = true
+				cfg.WhatsApp.SessionNameSuffix = true
+				cfg.WhatsApp.WarmupTimeout = 30 * time.Second
+				cfg.Server.SSLCertFile = "cert.pem"
+				cfg.Server.SSLKeyFile = "key.pem"
+			},
+			database: "pg",
+			want: map[string]bool{
+				"autoReconnect":     true,
+				"eventHistory":      true,
+				"qrTerminalOutput":  true,
+				"sessionNameSuffix": true,
+				"warmup":            true,
+				"tls":               true,
+			},
+		},
+		{
+			name: "tls needs both files",
+			config: func(cfg *config.Config) {
+				cfg.Server.SSLCertFile = "cert.pem"
+			},
+			database: "pg",
+			want:     map[string]bool{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{}
+			tt.config(cfg)
+			cfg.WhatsApp.MaxSessions = 7
+			cfg.WhatsApp.EventHistoryBytes = 2048
+
+			response := NewGetCapabilitiesUseCase(cfg, tt.database).Execute(context.Background())
+
+			want := map[string]bool{
+				"autoReconnect":      false,
+				"eventHistory":       false,
+				"qrTerminalOutput":   false,
+				"sessionNameSuffix":  false,
+				"warmup":             false,
+				"tls":                false,
+				"messagePersistence": false,
+				"mediaStorage":       false,
+				"webhookBatching":    false,
+			}
+			maps.Copy(want, tt.want)
+			if !maps.Equal(response.Features, want) {
+				t.Fatalf("Features = %v, want %v", response.Features, want)
+			}
+			if response.Database != tt.database {
+				t.Fatalf("Database = %q, want %q", response.Database, tt.database)
+			}
+			if response.Limits["maxSessions"] != 7 || response.Limits["eventHistoryBytes"] != 2048 {
+				t.Fatalf("Limits = %v", response.Limits)
+			}
+			if response.APIVersion != config.BuildVersion() {
+				t.Fatalf("APIVersion = %q, want %q", response.APIVersion, config.BuildVersion())
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package system
+
+import (
+	"context"
+
+	"wazmeow/internal/domain/services"
+)
+
+// GetEventRegistryUseCase handles listing the event types handled by the server
+type GetEventRegistryUseCase struct {
+	whatsappSvc services.WhatsAppService
+}
+
+// NewGetEventRegistryUseCase creates a new GetEventRegistryUseCase
+func NewGetEventRegistryUseCase(whatsappSvc services.WhatsAppService) *GetEventRegistryUseCase {
+	return &GetEventRegistryUseCase{
+		whatsappSvc: whatsappSvc,
+	}
+}
+
+// Execute returns the handled whatsmeow event types with their normalized names and descriptions
+func (uc *GetEventRegistryUseCase) Execute(ctx context.Context) []services.EventTypeInfo {
+	return uc.whatsappSvc.EventRegistry()
+}
@@ -0,0 +1,29 @@
+package system
+
+import (
+	"context"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/internal/domain/services"
+)
+
+// GetHealthUseCase handles reporting the health of the service and its WhatsApp clients
+type GetHealthUseCase struct {
+	whatsappSvc services.WhatsAppService
+}
+
+// NewGetHealthUseCase creates a new GetHealthUseCase
+func NewGetHealthUseCase(whatsappSvc services.WhatsAppService) *GetHealthUseCase {
+	return &GetHealthUseCase{
+		whatsappSvc: whatsappSvc,
+	}
+}
+
+// Execute returns the service status together with the client statistics
+func (uc *GetHealthUseCase) Execute(ctx context.Context) *dto.HealthResponse {
+	return &dto.HealthResponse{
+		Status:   "ok",
+		Service:  "wazmeow",
+		WhatsApp: uc.whatsappSvc.GetStats(),
+	}
+}
@@ -0,0 +1,38 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"wazmeow/internal/domain/services"
+	"wazmeow/pkg/logger"
+)
+
+// GetDevicesUseCase handles querying the devices of a contact
+type GetDevicesUseCase struct {
+	whatsappSvc services.WhatsAppService
+}
+
+// NewGetDevicesUseCase creates a new GetDevicesUseCase
+func NewGetDevicesUseCase(whatsappSvc services.WhatsAppService) *GetDevicesUseCase {
+	return &GetDevicesUseCase{
+		whatsappSvc: whatsappSvc,
+	}
+}
+
+// Execute returns the contact's devices and whether an encryption session exists for each
+func (uc *GetDevicesUseCase) Execute(ctx context.Context, sessionID, phone string) ([]services.UserDevice, error) {
+	if phone == "" {
+		return nil, errors.New("phone is required")
+	}
+
+	devices, err := uc.whatsappSvc.GetUserDevices(ctx, sessionID, phone)
+	if err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Str("phone", phone).Msg("Failed to get user devices")
+		return nil, err
+	}
+
+	logger.Info().Str("sessionId", sessionID).Str("phone", phone).Int("devices", len(devices)).Msg("User devices retrieved")
+
+	return devices, nil
+}
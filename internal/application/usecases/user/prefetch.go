@@ -0,0 +1,59 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/internal/domain/services"
+	"wazmeow/pkg/logger"
+)
+
+// maxPrefetchContacts is the maximum number of contacts accepted per prefetch request
+const maxPrefetchContacts = 100
+
+// ErrNoContacts is returned when a prefetch request has no phones
+var ErrNoContacts = errors.New("at least one phone is required")
+
+// ErrTooManyContacts is returned when a prefetch request exceeds maxPrefetchContacts
+var ErrTooManyContacts = errors.New("too many phones")
+
+// PrefetchContactsUseCase handles warming up the user info and device list caches of contacts.
+// It does not establish Signal sessions: whatsmeow only fetches prekeys when sending.
+type PrefetchContactsUseCase struct {
+	whatsappSvc services.WhatsAppService
+}
+
+// NewPrefetchContactsUseCase creates a new PrefetchContactsUseCase
+func NewPrefetchContactsUseCase(whatsappSvc services.WhatsAppService) *PrefetchContactsUseCase {
+	return &PrefetchContactsUseCase{
+		whatsappSvc: whatsappSvc,
+	}
+}
+
+// Execute resolves each phone and fetches its devices, reporting which succeeded
+func (uc *PrefetchContactsUseCase) Execute(ctx context.Context, sessionID string, req dto.PrefetchContactsRequest) (*dto.PrefetchContactsResponse, error) {
+	if len(req.Phones) == 0 {
+		return nil, ErrNoContacts
+	}
+	if len(req.Phones) > maxPrefetchContacts {
+		return nil, fmt.Errorf("%w: maximum is %d per request", ErrTooManyContacts, maxPrefetchContacts)
+	}
+
+	results, err := uc.whatsappSvc.PrefetchContacts(ctx, sessionID, req.Phones)
+	if err != nil {
+		logger.Error().Err(err).Str("sessionId", sessionID).Msg("Failed to prefetch contacts")
+		return nil, err
+	}
+
+	response := dto.ToPrefetchContactsResponse(results)
+
+	logger.Info().
+		Str("sessionId", sessionID).
+		Int("resolved", response.Resolved).
+		Int("failed", response.Failed).
+		Msg("Contacts prefetch completed")
+
+	return &response, nil
+}
@@ -0,0 +1,69 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"wazmeow/internal/application/dto"
+	"wazmeow/internal/domain/services"
+)
+
+// fakeWhatsAppService implements the prefetch of services.WhatsAppService; other methods panic
+type fakeWhatsAppService struct {
+	services.WhatsAppService
+	prefetched []string
+}
+
+func (s *fakeWhatsAppService) PrefetchContacts(_ context.Context, _ string, phones []string) ([]services.ContactPrefetchResult, error) {
+	s.prefetched = append(s.prefetched, phones...)
+	results := make([]services.ContactPrefetchResult, len(phones))
+	for i, phone := range phones {
+		results[i] = services.ContactPrefetchResult{Phone: phone, Resolved: phone != "bad"}
+	}
+	return results, nil
+}
+
+func TestPrefetchContacts(t *testing.T) {
+	tooMany := make([]string, maxPrefetchContacts+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprint(5511900000000 + i)
+	}
+
+	tests := []struct {
+		name         string
+		phones       []string
+		wantErr      error
+		wantResolved int
+		wantFailed   int
+	}{
+		{name: "no phones", phones: nil, wantErr: ErrNoContacts},
+		{name: "too many phones", phones: tooMany, wantErr: ErrTooManyContacts},
+		{name: "counts resolved and failed", phones: []string{"5511999999999", "bad", "5511888888888"}, wantResolved: 2, wantFailed: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &fakeWhatsAppService{}
+			uc := NewPrefetchContactsUseCase(svc)
+
+			response, err := uc.Execute(context.Background(), "s1", dto.PrefetchContactsRequest{Phones: tt.phones})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %v", err, tt.wantErr)
+				}
+				if len(svc.prefetched) != 0 {
+					t.Fatalf("prefetched %d phones on a rejected request", len(svc.prefetched))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if response.Resolved != tt.wantResolved || response.Failed != tt.wantFailed {
+				t.Fatalf("resolved/failed = %d/%d, want %d/%d", response.Resolved, response.Failed, tt.wantResolved, tt.wantFailed)
+			}
+		})
+	}
+}
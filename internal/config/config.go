@@ -2,12 +2,28 @@ package config
 
 import (
 	"os"
+	"runtime/debug"
 	"strconv"
 	"time"
 
 	"github.com/joho/godotenv"
 )
 
+// Version is the build version, set with -ldflags "-X wazmeow/internal/config.Version=<version>"
+var Version string
+
+// BuildVersion returns the version set at build time, falling back to the module version
+// recorded in the build info and then to "dev"
+func BuildVersion() string {
+	if Version != "" {
+		return Version
+	}
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "" && info.Main.Version != "(devel)" {
+		return info.Main.Version
+	}
+	return "dev"
+}
+
 // Config holds all configuration for the application
 type Config struct {
 	Database DatabaseConfig
@@ -48,6 +64,13 @@ type WhatsAppConfig struct {
 	PoolSize             int
 	PoolMaxIdle          int
 	PoolMaxLifetime      time.Duration
+	EventHistorySize     int
+	EventHistoryBytes    int
+	EventMaxBytes        int
+	MaxTextLength        int // WhatsApp apps cap text at 65536 chars; the Cloud API at 4096
+	QRTerminalOutput     bool
+	WarmupTimeout        time.Duration
+	SessionNameSuffix    bool
 }
 
 // LogConfig holds logging configuration
@@ -89,6 +112,13 @@ func Load() (*Config, error) {
 			PoolSize:             getEnvAsInt("WA_POOL_SIZE", 50),
 			PoolMaxIdle:          getEnvAsInt("WA_POOL_MAX_IDLE", 10),
 			PoolMaxLifetime:      getEnvAsDuration("WA_POOL_MAX_LIFETIME", time.Hour),
+			EventHistorySize:     getEnvAsInt("WA_EVENT_HISTORY_SIZE", 100),
+			EventHistoryBytes:    getEnvAsInt("WA_EVENT_HISTORY_MAX_BYTES", 1<<20),
+			EventMaxBytes:        getEnvAsInt("WA_EVENT_MAX_BYTES", 64<<10),
+			MaxTextLength:        getEnvAsInt("WA_MAX_TEXT_LENGTH", 65536),
+			QRTerminalOutput:     getEnvAsBool("QR_TERMINAL_OUTPUT", true),
+			WarmupTimeout:        getEnvAsDuration("WA_WARMUP_TIMEOUT", 30*time.Second),
+			SessionNameSuffix:    getEnvAsBool("WA_SESSION_NAME_AUTO_SUFFIX", false),
 		},
 		Log: LogConfig{
 			Level:  getEnv("LOG_LEVEL", "info"),
@@ -117,6 +147,16 @@ func getEnvAsInt(key string, fallback int) int {
 	return fallback
 }
 
+// getEnvAsBool gets an environment variable as boolean with a fallback value
+func getEnvAsBool(key string, fallback bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return fallback
+}
+
 // getEnvAsDuration gets an environment variable as duration with a fallback value
 func getEnvAsDuration(key string, fallback time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
@@ -2,6 +2,8 @@ package entities
 
 import (
 	"errors"
+	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,12 +18,48 @@ const (
 	StatusConnected    SessionStatus = "connected"    // Conectado e autenticado
 )
 
+// ErrSessionNameExists is returned when a session name is already in use
+var ErrSessionNameExists = errors.New("session name already exists")
+
+// ErrInvalidURL is returned when a webhook or proxy URL is malformed
+var ErrInvalidURL = errors.New("invalid URL")
+
 // ProxyConfig holds proxy configuration for a session
 type ProxyConfig struct {
 	Enabled  bool   `json:"enabled"`
 	ProxyURL string `json:"proxyURL,omitempty"`
 }
 
+// Validate checks that an enabled proxy has an http, https or socks5 URL
+func (c *ProxyConfig) Validate() error {
+	if !c.Enabled && c.ProxyURL == "" {
+		return nil
+	}
+	return validateURL(c.ProxyURL, "http", "https", "socks5")
+}
+
+// ValidateWebhookURL checks that a webhook URL, if set, is an absolute http or https URL
+func ValidateWebhookURL(webhookURL string) error {
+	if webhookURL == "" {
+		return nil
+	}
+	return validateURL(webhookURL, "http", "https")
+}
+
+// validateURL checks that raw is an absolute URL with a host and one of the given schemes
+func validateURL(raw string, schemes ...string) error {
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" {
+		return fmt.Errorf("%w: %q", ErrInvalidURL, raw)
+	}
+	for _, scheme := range schemes {
+		if parsed.Scheme == scheme {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: unsupported scheme %q", ErrInvalidURL, parsed.Scheme)
+}
+
 // Session representa uma sessão do WhatsApp
 type Session struct {
 	// ID único da sessão
@@ -32,6 +70,8 @@ type Session struct {
 	Status SessionStatus `json:"status" example:"connected"`
 	// Número de telefone associado (opcional)
 	Phone string `json:"phone,omitempty" example:"+5511999999999"`
+	// Número que deve ser pareado com a sessão; pareamentos com outra conta são recusados (opcional)
+	ClaimedPhone string `json:"claimedPhone,omitempty" example:"5511999999999"`
 
 	// JID do dispositivo WhatsApp (opcional)
 	DeviceJID string `json:"deviceJID,omitempty" example:"5511999999999.0:1@s.whatsapp.net"`
@@ -47,6 +87,17 @@ type Session struct {
 	// Eventos subscritos separados por vírgula (opcional)
 	Events string `json:"events,omitempty" example:"message,status"`
 
+	// Marca automaticamente mensagens recebidas como lidas
+	AutoMarkRead bool `json:"autoMarkRead"`
+
+	// Rejeita automaticamente chamadas recebidas
+	AutoRejectCalls bool `json:"autoRejectCalls"`
+	// Mensagem enviada ao autor de uma chamada rejeitada automaticamente (opcional)
+	AutoRejectMessage string `json:"autoRejectMessage,omitempty"`
+
+	// Indica se a sessão é salva no banco; sessões não persistentes existem apenas em memória
+	Persistent bool `json:"persistent"`
+
 	// Data de criação da sessão
 	CreatedAt time.Time `json:"createdAt" bun:"createdAt,nullzero,notnull,default:current_timestamp" example:"2023-08-19T10:30:00Z"`
 	// Data da última atualização
@@ -57,11 +108,12 @@ type Session struct {
 func NewSession(name string) *Session {
 	now := time.Now()
 	return &Session{
-		ID:        uuid.New().String(),
-		Name:      name,
-		Status:    StatusDisconnected,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:         uuid.New().String(),
+		Name:       name,
+		Status:     StatusDisconnected,
+		Persistent: true,
+		CreatedAt:  now,
+		UpdatedAt:  now,
 	}
 }
 
@@ -94,6 +146,23 @@ func (s *Session) SetPhone(phone string) {
 	s.UpdatedAt = time.Now()
 }
 
+// SetClaimedPhone sets the phone number the session must be paired with, keeping only digits
+func (s *Session) SetClaimedPhone(phone string) {
+	digits := make([]rune, 0, len(phone))
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			digits = append(digits, r)
+		}
+	}
+	s.ClaimedPhone = string(digits)
+	s.UpdatedAt = time.Now()
+}
+
+// MatchesClaimedPhone reports whether the paired phone satisfies the claimed phone, if any
+func (s *Session) MatchesClaimedPhone(phone string) bool {
+	return s.ClaimedPhone == "" || s.ClaimedPhone == phone
+}
+
 // SetWebhook sets the webhook URL and events
 func (s *Session) SetWebhook(url, events string) {
 	s.WebhookURL = url
@@ -107,6 +176,19 @@ func (s *Session) SetProxy(config *ProxyConfig) {
 	s.UpdatedAt = time.Now()
 }
 
+// SetAutoMarkRead enables or disables automatic read receipts for incoming messages
+func (s *Session) SetAutoMarkRead(enabled bool) {
+	s.AutoMarkRead = enabled
+	s.UpdatedAt = time.Now()
+}
+
+// SetAutoRejectCalls enables or disables automatic rejection of incoming calls
+func (s *Session) SetAutoRejectCalls(enabled bool, message string) {
+	s.AutoRejectCalls = enabled
+	s.AutoRejectMessage = message
+	s.UpdatedAt = time.Now()
+}
+
 // IsConnected returns true if the session is connected
 func (s *Session) IsConnected() bool {
 	return s.Status == StatusConnected
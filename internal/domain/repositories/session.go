@@ -14,6 +14,9 @@ type SessionRepository interface {
 	// GetByID retrieves a session by its ID
 	GetByID(ctx context.Context, id string) (*entities.Session, error)
 
+	// GetByName retrieves a session by its name
+	GetByName(ctx context.Context, name string) (*entities.Session, error)
+
 	// GetByDeviceJID retrieves a session by its device JID
 	GetByDeviceJID(ctx context.Context, deviceJID string) (*entities.Session, error)
 
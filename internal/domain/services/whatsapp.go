@@ -2,14 +2,22 @@ package services
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"time"
 
 	"wazmeow/internal/domain/entities"
 )
 
+// ErrCallNotFound is returned when a call is not among the recently received call offers
+var ErrCallNotFound = errors.New("call not found")
+
+// ErrSessionNotRunning is returned when a session has no client running in this process
+var ErrSessionNotRunning = errors.New("session not running")
+
 // WhatsAppService defines the interface for WhatsApp operations
 type WhatsAppService interface {
-	// StartSession starts a WhatsApp session
+	// StartSession starts a WhatsApp session, or reconnects/restarts the QR loop of a loaded one
 	StartSession(ctx context.Context, sessionID string) error
 
 	// StopSession stops a WhatsApp session
@@ -53,6 +61,59 @@ type WhatsAppService interface {
 
 	// GetAllSessionsInfo returns information about all active sessions
 	GetAllSessionsInfo() []map[string]interface{}
+
+	// GetStats returns client statistics such as connected sessions and active QR loops
+	GetStats() map[string]interface{}
+
+	// GetGroupInfo gets information about a group the session is part of
+	GetGroupInfo(ctx context.Context, sessionID, groupJID string) (*GroupInfo, error)
+
+	// GetGroupSettings gets the current settings of a group the session is part of
+	GetGroupSettings(ctx context.Context, sessionID, groupJID string) (*GroupSettings, error)
+
+	// GetUserDevices gets the devices of a contact and their encryption session status
+	GetUserDevices(ctx context.Context, sessionID, phone string) ([]UserDevice, error)
+
+	// PrefetchContacts caches the user info and devices of contacts ahead of messaging,
+	// without establishing encryption sessions
+	PrefetchContacts(ctx context.Context, sessionID string, phones []string) ([]ContactPrefetchResult, error)
+
+	// GetPushName gets the account push name and compares it with the session name
+	GetPushName(ctx context.Context, sessionID string) (*PushNameInfo, error)
+
+	// SyncPushName sets the account push name to the session name
+	SyncPushName(ctx context.Context, sessionID string) (*PushNameInfo, error)
+
+	// GetProfilePicture gets the account's own profile picture
+	GetProfilePicture(ctx context.Context, sessionID string) (*ProfilePicture, error)
+
+	// RejectCall rejects a recently received incoming call
+	RejectCall(ctx context.Context, sessionID, callID, from string) error
+
+	// GetRecentEvents gets the recent events of a session received after since
+	GetRecentEvents(sessionID string, since time.Time) ([]RecordedEvent, error)
+
+	// SetDebug enables or disables verbose WhatsApp client logging for a session
+	SetDebug(sessionID string, enabled bool) error
+
+	// SetAutoMarkRead refreshes the automatic read receipts flag used for incoming messages
+	SetAutoMarkRead(sessionID string, enabled bool)
+
+	// SetAutoReject refreshes the automatic call rejection settings used for incoming calls
+	SetAutoReject(sessionID string, enabled bool, message string)
+
+	// OnSessionRemoved registers a callback run after a session's client is removed,
+	// so caches kept outside the service can drop the session's entries
+	OnSessionRemoved(hook func(sessionID string))
+
+	// EventRegistry lists the event types handled by the server and their normalized names
+	EventRegistry() []EventTypeInfo
+
+	// GetEventHandlers gets the IDs of the event handlers registered on a session's client
+	GetEventHandlers(sessionID string) ([]uint32, error)
+
+	// ResetEventHandlers removes the event handlers the server registered on a session's client and registers the default set again
+	ResetEventHandlers(sessionID string) ([]uint32, error)
 }
 
 // SessionInfo holds detailed information about a WhatsApp session
@@ -60,11 +121,45 @@ type SessionInfo struct {
 	SessionID     string   `json:"sessionId"`
 	Connected     bool     `json:"connected"`
 	LoggedIn      bool     `json:"loggedIn"`
+	Ready         bool     `json:"ready"`
 	Phone         string   `json:"phone,omitempty"`
 	DeviceJID     string   `json:"deviceJID,omitempty"`
 	QRCode        string   `json:"qrCode,omitempty"`
 	Subscriptions []string `json:"subscriptions,omitempty"`
 	Webhook       string   `json:"webhook,omitempty"`
+	Debug         bool     `json:"debug"`
+}
+
+// GroupInfo holds information about a WhatsApp group
+type GroupInfo struct {
+	JID          string             `json:"jid"`
+	Name         string             `json:"name"`
+	Participants []GroupParticipant `json:"participants"`
+}
+
+// GroupSettings holds the current settings of a WhatsApp group
+type GroupSettings struct {
+	JID                  string `json:"jid"`
+	Announce             bool   `json:"announce"`
+	Locked               bool   `json:"locked"`
+	MemberAddMode        string `json:"memberAddMode"`
+	JoinApprovalRequired bool   `json:"joinApprovalRequired"`
+	DisappearingTimer    uint32 `json:"disappearingTimer"`
+}
+
+// GroupParticipant holds information about a member of a WhatsApp group
+type GroupParticipant struct {
+	JID          string `json:"jid"`
+	Phone        string `json:"phone,omitempty"`
+	IsAdmin      bool   `json:"isAdmin"`
+	IsSuperAdmin bool   `json:"isSuperAdmin"`
+	JoinMethod   string `json:"joinMethod,omitempty"`
+}
+
+// UserDevice holds information about a device of a WhatsApp contact
+type UserDevice struct {
+	JID        string `json:"jid"`
+	HasSession bool   `json:"hasSession"`
 }
 
 // QRCodeData represents QR code information
@@ -81,6 +176,50 @@ type PairData struct {
 	ExpiresAt   time.Time `json:"expiresAt"`
 }
 
+// ContactPrefetchResult holds the outcome of prefetching a single contact
+type ContactPrefetchResult struct {
+	Phone    string `json:"phone"`
+	JID      string `json:"jid,omitempty"`
+	Resolved bool   `json:"resolved"`
+	Devices  int    `json:"devices"`
+	Error    string `json:"error,omitempty"`
+}
+
+// PushNameInfo holds the account push name and the session name it should match
+type PushNameInfo struct {
+	PushName    string `json:"pushName"`
+	SessionName string `json:"sessionName"`
+	Diverged    bool   `json:"diverged"`
+}
+
+// ProfilePicture holds the account's own profile picture
+type ProfilePicture struct {
+	JID       string `json:"jid"`
+	Set       bool   `json:"set"`
+	PictureID string `json:"pictureID,omitempty"`
+	URL       string `json:"url,omitempty"`
+	Type      string `json:"type,omitempty"`
+	Changed   bool   `json:"changed"`
+}
+
+// EventTypeInfo describes an event type handled by the server
+type EventTypeInfo struct {
+	WhatsmeowType      string `json:"whatsmeowType,omitempty"`
+	Name               string `json:"name"`
+	Description        string `json:"description"`
+	ForwardedByDefault bool   `json:"forwardedByDefault"`
+}
+
+// RecordedEvent represents a serialized event kept for replay
+type RecordedEvent struct {
+	Sequence  uint64          `json:"sequence"`
+	Type      string          `json:"type"`
+	Timestamp time.Time       `json:"timestamp"`
+	Payload   json.RawMessage `json:"payload"`
+	// Omitted explains why the payload was not kept (sensitive or too large)
+	Omitted string `json:"omitted,omitempty"`
+}
+
 // WhatsAppEvent represents events from WhatsApp client
 type WhatsAppEvent struct {
 	Type      string                 `json:"type"`
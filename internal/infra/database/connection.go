@@ -35,6 +35,7 @@ func NewConnection(cfg config.DatabaseConfig) (*bun.DB, error) {
 
 	// Test connection
 	if err := db.Ping(); err != nil {
+		_ = db.Close()
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
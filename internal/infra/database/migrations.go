@@ -2,6 +2,8 @@ package database
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/uptrace/bun"
 
@@ -31,6 +33,11 @@ func RunMigrations(db *bun.DB) error {
 		}
 	}
 
+	// Add columns introduced after the initial schema
+	if err := addColumns(ctx, db); err != nil {
+		return err
+	}
+
 	// Create indexes using Bun query builder (zero SQL)
 	if err := createIndexes(ctx, db); err != nil {
 		return err
@@ -40,6 +47,30 @@ func RunMigrations(db *bun.DB) error {
 	return nil
 }
 
+// addColumns adds columns missing from tables created by older versions
+func addColumns(ctx context.Context, db *bun.DB) error {
+	columns := []string{
+		`"autoMarkRead" BOOLEAN NOT NULL DEFAULT FALSE`,
+		`"autoRejectCalls" BOOLEAN NOT NULL DEFAULT FALSE`,
+		`"autoRejectMessage" VARCHAR`,
+		`"claimedPhone" VARCHAR`,
+	}
+
+	for _, column := range columns {
+		_, err := db.NewAddColumn().
+			Model((*models.SessionModel)(nil)).
+			ColumnExpr(column).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			logger.Error().Err(err).Str("column", column).Msg("Failed to add column")
+			return err
+		}
+	}
+
+	return nil
+}
+
 // createIndexes creates database indexes using Bun query builder
 func createIndexes(ctx context.Context, db *bun.DB) error {
 	// Create index on Sessions.status
@@ -78,6 +109,44 @@ func createIndexes(ctx context.Context, db *bun.DB) error {
 		return err
 	}
 
+	// Create unique index on Sessions.name, refusing to start while duplicates exist
+	if err := checkDuplicateNames(ctx, db); err != nil {
+		return err
+	}
+	_, err = db.NewCreateIndex().
+		Model((*models.SessionModel)(nil)).
+		Unique().
+		Index(models.SessionNameIndex).
+		Column("name").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to create unique name index")
+		return err
+	}
+
 	logger.Debug().Msg("Database indexes created successfully")
 	return nil
 }
+
+// checkDuplicateNames reports session names used more than once, which block the unique name index
+func checkDuplicateNames(ctx context.Context, db *bun.DB) error {
+	var names []string
+	err := db.NewSelect().
+		Model((*models.SessionModel)(nil)).
+		Column("name").
+		Group("name").
+		Having("count(*) > 1").
+		Scan(ctx, &names)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to check duplicate session names")
+		return err
+	}
+
+	if len(names) > 0 {
+		logger.Error().Strs("names", names).Msg("Duplicate session names found, rename them before starting")
+		return fmt.Errorf("duplicate session names prevent the unique name index: %s", strings.Join(names, ", "))
+	}
+
+	return nil
+}
@@ -8,31 +8,40 @@ import (
 	"wazmeow/internal/domain/entities"
 )
 
+// SessionNameIndex is the unique index on session names created by the migrations
+const SessionNameIndex = "idx_sessions_name"
+
 // SessionModel represents the session table in the database
 type SessionModel struct {
 	bun.BaseModel `bun:"table:Sessions,alias:s"`
 
-	ID           string    `bun:"id,pk" json:"id"`
-	Name         string    `bun:"name,notnull" json:"name"`
-	Status       string    `bun:"status,notnull,default:'disconnected'" json:"status"`
-	Phone        *string   `bun:"phone" json:"phone,omitempty"`
-	DeviceJID    *string   `bun:"deviceJID" json:"deviceJID,omitempty"`
-	ProxyEnabled bool      `bun:"proxyEnabled,default:false" json:"proxyEnabled"`
-	ProxyURL     *string   `bun:"proxyURL" json:"proxyURL,omitempty"`
-	WebhookURL   *string   `bun:"webhookURL" json:"webhookURL,omitempty"`
-	Events       *string   `bun:"events" json:"events,omitempty"`
-	CreatedAt    time.Time `bun:"createdAt,nullzero,notnull,default:current_timestamp" json:"createdAt"`
-	UpdatedAt    time.Time `bun:"updatedAt,nullzero,notnull,default:current_timestamp" json:"updatedAt"`
+	ID                string    `bun:"id,pk" json:"id"`
+	Name              string    `bun:"name,notnull" json:"name"`
+	Status            string    `bun:"status,notnull,default:'disconnected'" json:"status"`
+	Phone             *string   `bun:"phone" json:"phone,omitempty"`
+	ClaimedPhone      *string   `bun:"claimedPhone" json:"claimedPhone,omitempty"`
+	DeviceJID         *string   `bun:"deviceJID" json:"deviceJID,omitempty"`
+	ProxyEnabled      bool      `bun:"proxyEnabled,default:false" json:"proxyEnabled"`
+	ProxyURL          *string   `bun:"proxyURL" json:"proxyURL,omitempty"`
+	WebhookURL        *string   `bun:"webhookURL" json:"webhookURL,omitempty"`
+	Events            *string   `bun:"events" json:"events,omitempty"`
+	AutoMarkRead      bool      `bun:"autoMarkRead,notnull,default:false" json:"autoMarkRead"`
+	AutoRejectCalls   bool      `bun:"autoRejectCalls,notnull,default:false" json:"autoRejectCalls"`
+	AutoRejectMessage *string   `bun:"autoRejectMessage" json:"autoRejectMessage,omitempty"`
+	CreatedAt         time.Time `bun:"createdAt,nullzero,notnull,default:current_timestamp" json:"createdAt"`
+	UpdatedAt         time.Time `bun:"updatedAt,nullzero,notnull,default:current_timestamp" json:"updatedAt"`
 }
 
 // ToEntity converts the database model to a domain entity
 func (m *SessionModel) ToEntity() *entities.Session {
 	session := &entities.Session{
-		ID:        m.ID,
-		Name:      m.Name,
-		Status:    entities.SessionStatus(m.Status),
-		CreatedAt: m.CreatedAt,
-		UpdatedAt: m.UpdatedAt,
+		ID:           m.ID,
+		Name:         m.Name,
+		Status:       entities.SessionStatus(m.Status),
+		Persistent:   true,
+		AutoMarkRead: m.AutoMarkRead,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
 	}
 
 	if m.Phone != nil {
@@ -58,6 +67,15 @@ func (m *SessionModel) ToEntity() *entities.Session {
 		session.Events = *m.Events
 	}
 
+	if m.ClaimedPhone != nil {
+		session.ClaimedPhone = *m.ClaimedPhone
+	}
+
+	session.AutoRejectCalls = m.AutoRejectCalls
+	if m.AutoRejectMessage != nil {
+		session.AutoRejectMessage = *m.AutoRejectMessage
+	}
+
 	return session
 }
 
@@ -66,6 +84,8 @@ func (m *SessionModel) FromEntity(session *entities.Session) {
 	m.ID = session.ID
 	m.Name = session.Name
 	m.Status = string(session.Status)
+	m.AutoMarkRead = session.AutoMarkRead
+	m.AutoRejectCalls = session.AutoRejectCalls
 	m.CreatedAt = session.CreatedAt
 	m.UpdatedAt = session.UpdatedAt
 
@@ -91,6 +111,14 @@ func (m *SessionModel) FromEntity(session *entities.Session) {
 	if session.Events != "" {
 		m.Events = &session.Events
 	}
+
+	if session.ClaimedPhone != "" {
+		m.ClaimedPhone = &session.ClaimedPhone
+	}
+
+	if session.AutoRejectMessage != "" {
+		m.AutoRejectMessage = &session.AutoRejectMessage
+	}
 }
 
 // NewSessionModel creates a new SessionModel from a domain entity
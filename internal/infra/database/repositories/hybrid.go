@@ -0,0 +1,171 @@
+package repositories
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"wazmeow/internal/domain/entities"
+	"wazmeow/internal/domain/repositories"
+	"wazmeow/pkg/logger"
+)
+
+// hybridSessionRepository keeps non-persistent sessions in memory and delegates the rest to the database
+type hybridSessionRepository struct {
+	persistent repositories.SessionRepository
+	memory     sync.Map // string -> *entities.Session
+
+	// createMu serializes Create so a name can't be taken in one store while it is checked in the other
+	createMu sync.Mutex
+}
+
+// NewHybridSessionRepository wraps a persistent repository so non-persistent sessions never reach the database
+func NewHybridSessionRepository(persistent repositories.SessionRepository) repositories.SessionRepository {
+	return &hybridSessionRepository{persistent: persistent}
+}
+
+// Create stores non-persistent sessions in memory and persists the rest.
+// Names are unique across both stores.
+func (r *hybridSessionRepository) Create(ctx context.Context, session *entities.Session) error {
+	r.createMu.Lock()
+	defer r.createMu.Unlock()
+
+	if taken := r.filter(func(existing *entities.Session) bool { return existing.Name == session.Name }); len(taken) > 0 {
+		return entities.ErrSessionNameExists
+	}
+
+	if !session.Persistent {
+		existing, err := r.persistent.GetByName(ctx, session.Name)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return entities.ErrSessionNameExists
+		}
+
+		r.memory.Store(session.ID, copySession(session))
+		logger.Debug().Str("sessionId", session.ID).Msg("Non-persistent session created in memory")
+		return nil
+	}
+	return r.persistent.Create(ctx, session)
+}
+
+// GetByID retrieves a session from memory or the database
+func (r *hybridSessionRepository) GetByID(ctx context.Context, id string) (*entities.Session, error) {
+	if session, ok := r.load(id); ok {
+		return session, nil
+	}
+	return r.persistent.GetByID(ctx, id)
+}
+
+// GetByName retrieves a session by its name from memory or the database,
+// preferring the oldest in-memory session when names collide
+func (r *hybridSessionRepository) GetByName(ctx context.Context, name string) (*entities.Session, error) {
+	if sessions := r.filter(func(session *entities.Session) bool { return session.Name == name }); len(sessions) > 0 {
+		sort.Slice(sessions, func(i, j int) bool {
+			if !sessions[i].CreatedAt.Equal(sessions[j].CreatedAt) {
+				return sessions[i].CreatedAt.Before(sessions[j].CreatedAt)
+			}
+			return sessions[i].ID < sessions[j].ID
+		})
+		return sessions[0], nil
+	}
+	return r.persistent.GetByName(ctx, name)
+}
+
+// GetByDeviceJID retrieves a session by its device JID from memory or the database
+func (r *hybridSessionRepository) GetByDeviceJID(ctx context.Context, deviceJID string) (*entities.Session, error) {
+	var found *entities.Session
+	r.memory.Range(func(key, value interface{}) bool {
+		if session := value.(*entities.Session); session.DeviceJID == deviceJID {
+			found = copySession(session)
+			return false
+		}
+		return true
+	})
+	if found != nil {
+		return found, nil
+	}
+	return r.persistent.GetByDeviceJID(ctx, deviceJID)
+}
+
+// GetAll retrieves all sessions, merging in-memory sessions with the persisted ones
+func (r *hybridSessionRepository) GetAll(ctx context.Context) ([]*entities.Session, error) {
+	sessions, err := r.persistent.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions = append(sessions, r.filter(func(*entities.Session) bool { return true })...)
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].CreatedAt.After(sessions[j].CreatedAt)
+	})
+	return sessions, nil
+}
+
+// GetConnectedSessions retrieves all connected sessions from memory and the database
+func (r *hybridSessionRepository) GetConnectedSessions(ctx context.Context) ([]*entities.Session, error) {
+	sessions, err := r.persistent.GetConnectedSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return append(sessions, r.filter((*entities.Session).IsConnected)...), nil
+}
+
+// Update updates a session in memory or in the database
+func (r *hybridSessionRepository) Update(ctx context.Context, session *entities.Session) error {
+	if _, ok := r.memory.Load(session.ID); ok {
+		r.memory.Store(session.ID, copySession(session))
+		return nil
+	}
+	return r.persistent.Update(ctx, session)
+}
+
+// Delete deletes a session from memory or from the database
+func (r *hybridSessionRepository) Delete(ctx context.Context, id string) error {
+	if _, ok := r.memory.LoadAndDelete(id); ok {
+		return nil
+	}
+	return r.persistent.Delete(ctx, id)
+}
+
+// UpdateStatus updates only the status of a session in memory or in the database
+func (r *hybridSessionRepository) UpdateStatus(ctx context.Context, id string, status entities.SessionStatus) error {
+	if session, ok := r.load(id); ok {
+		session.UpdateStatus(status)
+		r.memory.Store(id, session)
+		return nil
+	}
+	return r.persistent.UpdateStatus(ctx, id, status)
+}
+
+// load returns a copy of an in-memory session
+func (r *hybridSessionRepository) load(id string) (*entities.Session, bool) {
+	value, ok := r.memory.Load(id)
+	if !ok {
+		return nil, false
+	}
+	return copySession(value.(*entities.Session)), true
+}
+
+// filter returns copies of the in-memory sessions matching the predicate
+func (r *hybridSessionRepository) filter(match func(*entities.Session) bool) []*entities.Session {
+	var sessions []*entities.Session
+	r.memory.Range(func(key, value interface{}) bool {
+		if session := value.(*entities.Session); match(session) {
+			sessions = append(sessions, copySession(session))
+		}
+		return true
+	})
+	return sessions
+}
+
+// copySession returns a shallow copy so callers can't mutate the stored session without Update
+func copySession(session *entities.Session) *entities.Session {
+	clone := *session
+	if session.ProxyConfig != nil {
+		proxy := *session.ProxyConfig
+		clone.ProxyConfig = &proxy
+	}
+	return &clone
+}
@@ -0,0 +1,118 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"wazmeow/internal/domain/entities"
+	"wazmeow/internal/domain/repositories"
+)
+
+// namedRepo is an in-memory persistent store that rejects duplicate names like the database index
+type namedRepo struct {
+	repositories.SessionRepository
+
+	mu       sync.Mutex
+	sessions map[string]*entities.Session
+	err      error
+}
+
+func newNamedRepo() *namedRepo {
+	return &namedRepo{sessions: make(map[string]*entities.Session)}
+}
+
+func (r *namedRepo) Create(_ context.Context, session *entities.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.sessions[session.Name]; exists {
+		return entities.ErrSessionNameExists
+	}
+	r.sessions[session.Name] = session
+	return nil
+}
+
+func (r *namedRepo) GetByName(_ context.Context, name string) (*entities.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.sessions[name], r.err
+}
+
+func newHybridSession(name string, persistent bool) *entities.Session {
+	session := entities.NewSession(name)
+	session.Persistent = persistent
+	return session
+}
+
+func TestHybridCreateUniqueNames(t *testing.T) {
+	errLookup := errors.New("lookup failed")
+
+	tests := []struct {
+		name       string
+		existing   *entities.Session
+		persistent bool
+		lookupErr  error
+		wantErr    error
+	}{
+		{name: "memory name free", persistent: false},
+		{name: "database name free", persistent: true},
+		{name: "memory duplicate of memory", existing: newHybridSession("sales", false), persistent: false, wantErr: entities.ErrSessionNameExists},
+		{name: "memory duplicate of database", existing: newHybridSession("sales", true), persistent: false, wantErr: entities.ErrSessionNameExists},
+		{name: "database duplicate of memory", existing: newHybridSession("sales", false), persistent: true, wantErr: entities.ErrSessionNameExists},
+		{name: "database duplicate of database", existing: newHybridSession("sales", true), persistent: true, wantErr: entities.ErrSessionNameExists},
+		{name: "lookup error", persistent: false, lookupErr: errLookup, wantErr: errLookup},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewHybridSessionRepository(newNamedRepo())
+			ctx := context.Background()
+			if tt.existing != nil {
+				if err := repo.Create(ctx, tt.existing); err != nil {
+					t.Fatalf("Create(existing) error = %v", err)
+				}
+			}
+			repo.(*hybridSessionRepository).persistent.(*namedRepo).err = tt.lookupErr
+
+			session := newHybridSession("sales", tt.persistent)
+			err := repo.Create(ctx, session)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Create() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if found, _ := repo.GetByName(ctx, "sales"); found == nil || found.ID != session.ID {
+					t.Fatalf("GetByName() = %+v, want session %s", found, session.ID)
+				}
+				stored := repo.(*hybridSessionRepository).persistent.(*namedRepo).sessions["sales"]
+				if inDatabase := stored != nil && stored.ID == session.ID; inDatabase != tt.persistent {
+					t.Fatalf("session in persistent store = %v, want %v", inDatabase, tt.persistent)
+				}
+			}
+		})
+	}
+}
+
+func TestHybridCreateConcurrent(t *testing.T) {
+	repo := NewHybridSessionRepository(newNamedRepo())
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	created := 0
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(persistent bool) {
+			defer wg.Done()
+			if err := repo.Create(context.Background(), newHybridSession("sales", persistent)); err == nil {
+				mu.Lock()
+				created++
+				mu.Unlock()
+			}
+		}(i%2 == 0)
+	}
+	wg.Wait()
+
+	if created != 1 {
+		t.Fatalf("created %d sessions named sales, want 1", created)
+	}
+}
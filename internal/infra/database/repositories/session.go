@@ -2,9 +2,12 @@ package repositories
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
 
 	"wazmeow/internal/domain/entities"
 	"wazmeow/internal/domain/repositories"
@@ -12,6 +15,9 @@ import (
 	"wazmeow/pkg/logger"
 )
 
+// pgUniqueViolation is the Postgres SQLSTATE for unique constraint violations
+const pgUniqueViolation = "23505"
+
 // sessionRepository implements the SessionRepository interface using Bun ORM
 type sessionRepository struct {
 	db *bun.DB
@@ -31,6 +37,9 @@ func (r *sessionRepository) Create(ctx context.Context, session *entities.Sessio
 		Exec(ctx)
 
 	if err != nil {
+		if isNameViolation(err) {
+			return entities.ErrSessionNameExists
+		}
 		logger.Error().Err(err).Str("sessionId", session.ID).Msg("Failed to create session")
 		return err
 	}
@@ -59,6 +68,26 @@ func (r *sessionRepository) GetByID(ctx context.Context, id string) (*entities.S
 	return model.ToEntity(), nil
 }
 
+// GetByName retrieves a session by its name using Bun query builder
+func (r *sessionRepository) GetByName(ctx context.Context, name string) (*entities.Session, error) {
+	model := &models.SessionModel{}
+
+	err := r.db.NewSelect().
+		Model(model).
+		Where("name = ?", name).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		logger.Error().Err(err).Str("name", name).Msg("Failed to get session by name")
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
+
 // GetByDeviceJID retrieves a session by its device JID using Bun query builder
 func (r *sessionRepository) GetByDeviceJID(ctx context.Context, deviceJID string) (*entities.Session, error) {
 	model := &models.SessionModel{}
@@ -176,3 +205,13 @@ func (r *sessionRepository) UpdateStatus(ctx context.Context, id string, status
 	logger.Debug().Str("sessionId", id).Str("status", string(status)).Msg("Session status updated successfully")
 	return nil
 }
+
+// isNameViolation checks whether an error is a unique violation of the session name index.
+// Only Postgres through pgdriver is supported, the only driver opened by database.NewConnection.
+func isNameViolation(err error) bool {
+	var pgErr pgdriver.Error
+	if !errors.As(err, &pgErr) || pgErr.Field('C') != pgUniqueViolation {
+		return false
+	}
+	return pgErr.Field('n') == models.SessionNameIndex
+}
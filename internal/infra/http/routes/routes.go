@@ -1,23 +1,47 @@
 package routes
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
 
 	"wazmeow/internal/application/handlers"
+	"wazmeow/internal/config"
 )
 
 // SetupRoutes configures all routes for the API
-func SetupRoutes(router chi.Router, sessionHandler *handlers.SessionHandler) {
+func SetupRoutes(router chi.Router, sessionHandler *handlers.SessionHandler, groupHandler *handlers.GroupHandler, userHandler *handlers.UserHandler, messageHandler *handlers.MessageHandler, profileHandler *handlers.ProfileHandler, systemHandler *handlers.SystemHandler, callHandler *handlers.CallHandler) {
 	// Health check endpoint
-	router.Get("/health", healthCheckHandler)
+	router.Get("/health", systemHandler.Health)
+
+	// Capabilities endpoint
+	router.Get("/capabilities", systemHandler.GetCapabilities)
+	router.Get("/events/registry", systemHandler.GetEventRegistry)
 
 	// Root endpoint
 	router.Get("/", rootHandler)
 
 	// Session management routes (direct paths as specified)
 	setupSessionRoutes(router, sessionHandler)
+
+	// Admin/debug routes
+	setupAdminRoutes(router, sessionHandler)
+
+	// Group routes
+	setupGroupRoutes(router, groupHandler)
+
+	// User routes
+	setupUserRoutes(router, userHandler)
+
+	// Message routes
+	setupMessageRoutes(router, messageHandler)
+
+	// Profile routes
+	setupProfileRoutes(router, profileHandler)
+
+	// Call routes
+	setupCallRoutes(router, callHandler)
 }
 
 // setupSessionRoutes configures session management routes
@@ -25,6 +49,7 @@ func setupSessionRoutes(router chi.Router, sessionHandler *handlers.SessionHandl
 	router.Route("/sessions", func(r chi.Router) {
 		// Session collection routes
 		r.Post("/add", sessionHandler.CreateSession)
+		r.Post("/create-and-connect", sessionHandler.CreateAndConnectSession)
 		r.Get("/list", sessionHandler.ListSessions)
 
 		// Session-specific routes
@@ -36,20 +61,72 @@ func setupSessionRoutes(router chi.Router, sessionHandler *handlers.SessionHandl
 			r.Get("/qr", sessionHandler.GetQRCode)
 			r.Post("/pairphone", sessionHandler.PairPhone)
 			r.Post("/proxy/set", sessionHandler.SetProxy)
+			r.Post("/autoread/set", sessionHandler.SetAutoMarkRead)
+			r.Post("/calls/autoreject/set", sessionHandler.SetAutoRejectCalls)
+			r.Get("/events/recent", sessionHandler.GetRecentEvents)
+			r.Get("/config/export", sessionHandler.ExportConfig)
+			r.Post("/config/import", sessionHandler.ImportConfig)
+			r.Put("/debug", sessionHandler.SetDebug)
 		})
 	})
 }
 
-// healthCheckHandler handles health check requests
-func healthCheckHandler(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	w.Write([]byte(`{"status":"ok","service":"wazmeow"}`))
+// setupAdminRoutes configures administrative/debug routes
+func setupAdminRoutes(router chi.Router, sessionHandler *handlers.SessionHandler) {
+	router.Route("/admin/sessions/{sessionID}", func(r chi.Router) {
+		r.Get("/handlers", sessionHandler.GetEventHandlers)
+		r.Post("/handlers/reset", sessionHandler.ResetEventHandlers)
+	})
+}
+
+// setupGroupRoutes configures group management routes
+func setupGroupRoutes(router chi.Router, groupHandler *handlers.GroupHandler) {
+	router.Route("/group/{sessionID}", func(r chi.Router) {
+		r.Get("/participants.csv", groupHandler.ExportParticipantsCSV)
+		r.Post("/info/batch", groupHandler.GetGroupInfoBatch)
+		r.Get("/settings", groupHandler.GetGroupSettings)
+	})
+}
+
+// setupUserRoutes configures contact/user routes
+func setupUserRoutes(router chi.Router, userHandler *handlers.UserHandler) {
+	router.Route("/user/{sessionID}", func(r chi.Router) {
+		r.Get("/devices", userHandler.GetDevices)
+		r.Post("/contacts/prefetch", userHandler.PrefetchContacts)
+		r.Post("/contacts/import", userHandler.PrefetchContacts) // alias kept for existing clients
+	})
+}
+
+// setupMessageRoutes configures message routes
+func setupMessageRoutes(router chi.Router, messageHandler *handlers.MessageHandler) {
+	router.Route("/message", func(r chi.Router) {
+		r.Get("/estimate", messageHandler.EstimateMessage)
+		r.Post("/preview", messageHandler.PreviewMessage)
+	})
+}
+
+// setupProfileRoutes configures account profile routes
+func setupProfileRoutes(router chi.Router, profileHandler *handlers.ProfileHandler) {
+	router.Route("/profile/{sessionID}", func(r chi.Router) {
+		r.Get("/pushname", profileHandler.GetPushName)
+		r.Post("/pushname/sync", profileHandler.SyncPushName)
+		r.Get("/picture", profileHandler.GetProfilePicture)
+	})
+}
+
+// setupCallRoutes configures call routes
+func setupCallRoutes(router chi.Router, callHandler *handlers.CallHandler) {
+	router.Route("/call/{sessionID}", func(r chi.Router) {
+		r.Post("/reject", callHandler.RejectCall)
+	})
 }
 
 // rootHandler handles root endpoint requests
 func rootHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	w.Write([]byte(`{"message":"WazMeow API - WhatsApp Session Management","version":"1.0.0"}`))
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "WazMeow API - WhatsApp Session Management",
+		"version": config.BuildVersion(),
+	})
 }
@@ -2,6 +2,7 @@ package http
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -9,35 +10,42 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/uptrace/bun"
+	"go.mau.fi/whatsmeow/store/sqlstore"
 
 	"wazmeow/internal/application/handlers"
+	"wazmeow/internal/application/usecases/call"
+	"wazmeow/internal/application/usecases/group"
+	"wazmeow/internal/application/usecases/message"
+	"wazmeow/internal/application/usecases/profile"
 	"wazmeow/internal/application/usecases/session"
+	"wazmeow/internal/application/usecases/system"
+	"wazmeow/internal/application/usecases/user"
 	"wazmeow/internal/config"
 	"wazmeow/internal/infra/database/repositories"
 	"wazmeow/internal/infra/http/routes"
 	"wazmeow/internal/infra/whatsapp"
-	"wazmeow/internal/infra/whatsapp/store"
 	"wazmeow/pkg/logger"
 )
 
 // Server represents the HTTP server
 type Server struct {
-	config     *config.Config
-	httpServer *http.Server
-	router     chi.Router
+	config          *config.Config
+	httpServer      *http.Server
+	router          chi.Router
+	whatsappService *whatsapp.Service
 }
 
-// NewServer creates a new HTTP server instance
-func NewServer(cfg *config.Config, db *bun.DB, waStore interface{}) (*Server, error) {
+// NewServer creates a new HTTP server instance using the given database and WhatsApp store
+func NewServer(cfg *config.Config, db *bun.DB, waStore *sqlstore.Container) (*Server, error) {
+	if db == nil || waStore == nil {
+		return nil, errors.New("database and WhatsApp store are required")
+	}
+
 	// Initialize repositories
-	sessionRepo := repositories.NewSessionRepository(db)
+	sessionRepo := repositories.NewHybridSessionRepository(repositories.NewSessionRepository(db))
 
-	// Initialize WhatsApp store and service
-	whatsappStore, err := store.NewContainer(cfg.Database)
-	if err != nil {
-		logger.Fatal().Err(err).Msg("Failed to initialize WhatsApp store")
-	}
-	whatsappService := whatsapp.NewService(sessionRepo, whatsappStore, &cfg.WhatsApp)
+	// Initialize WhatsApp service
+	whatsappService := whatsapp.NewService(sessionRepo, waStore, &cfg.WhatsApp)
 
 	// Initialize WhatsApp service and load sessions for auto-reconnect
 	ctx := context.Background()
@@ -47,12 +55,38 @@ func NewServer(cfg *config.Config, db *bun.DB, waStore interface{}) (*Server, er
 	}
 
 	// Initialize use cases
-	createSessionUC := session.NewCreateSessionUseCase(sessionRepo)
+	createSessionUC := session.NewCreateSessionUseCase(sessionRepo, cfg.WhatsApp.SessionNameSuffix)
 	listSessionsUC := session.NewListSessionsUseCase(sessionRepo)
 	connectSessionUC := session.NewConnectSessionUseCase(sessionRepo, whatsappService)
+	autoReadUC := session.NewSetAutoMarkReadUseCase(sessionRepo, whatsappService)
+	autoRejectUC := session.NewSetAutoRejectCallsUseCase(sessionRepo, whatsappService)
+	createConnectUC := session.NewCreateAndConnectSessionUseCase(createSessionUC, connectSessionUC, sessionRepo, whatsappService)
+	exportConfigUC := session.NewExportSessionConfigUseCase(sessionRepo)
+	importConfigUC := session.NewImportSessionConfigUseCase(sessionRepo, whatsappService)
+	exportParticipantsUC := group.NewExportParticipantsUseCase(whatsappService)
+	batchGroupInfoUC := group.NewBatchGroupInfoUseCase(whatsappService)
+	whatsappService.OnSessionRemoved(batchGroupInfoUC.Forget)
+	groupSettingsUC := group.NewGetGroupSettingsUseCase(whatsappService)
+	getUserDevicesUC := user.NewGetDevicesUseCase(whatsappService)
+	prefetchContactsUC := user.NewPrefetchContactsUseCase(whatsappService)
+	estimateMessageUC := message.NewEstimateMessageUseCase(cfg.WhatsApp.MaxTextLength)
+	previewMessageUC := message.NewPreviewMessageUseCase(cfg.WhatsApp.MaxTextLength)
+	getPushNameUC := profile.NewGetPushNameUseCase(whatsappService)
+	syncPushNameUC := profile.NewSyncPushNameUseCase(whatsappService)
+	getProfilePictureUC := profile.NewGetProfilePictureUseCase(whatsappService)
+	healthUC := system.NewGetHealthUseCase(whatsappService)
+	capabilitiesUC := system.NewGetCapabilitiesUseCase(cfg, db.Dialect().Name().String())
+	eventRegistryUC := system.NewGetEventRegistryUseCase(whatsappService)
+	rejectCallUC := call.NewRejectCallUseCase(whatsappService)
 
 	// Initialize handlers
-	sessionHandler := handlers.NewSessionHandler(createSessionUC, listSessionsUC, connectSessionUC, whatsappService)
+	sessionHandler := handlers.NewSessionHandler(createSessionUC, listSessionsUC, connectSessionUC, autoReadUC, autoRejectUC, createConnectUC, exportConfigUC, importConfigUC, whatsappService)
+	groupHandler := handlers.NewGroupHandler(exportParticipantsUC, batchGroupInfoUC, groupSettingsUC)
+	userHandler := handlers.NewUserHandler(getUserDevicesUC, prefetchContactsUC)
+	messageHandler := handlers.NewMessageHandler(estimateMessageUC, previewMessageUC)
+	profileHandler := handlers.NewProfileHandler(getPushNameUC, syncPushNameUC, getProfilePictureUC)
+	systemHandler := handlers.NewSystemHandler(healthUC, capabilitiesUC, eventRegistryUC)
+	callHandler := handlers.NewCallHandler(rejectCallUC)
 
 	// Create router
 	router := chi.NewRouter()
@@ -61,7 +95,7 @@ func NewServer(cfg *config.Config, db *bun.DB, waStore interface{}) (*Server, er
 	setupMiddleware(router)
 
 	// Setup routes
-	routes.SetupRoutes(router, sessionHandler)
+	routes.SetupRoutes(router, sessionHandler, groupHandler, userHandler, messageHandler, profileHandler, systemHandler, callHandler)
 
 	// Create HTTP server
 	addr := fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port)
@@ -74,9 +108,10 @@ func NewServer(cfg *config.Config, db *bun.DB, waStore interface{}) (*Server, er
 	}
 
 	server := &Server{
-		config:     cfg,
-		httpServer: httpServer,
-		router:     router,
+		config:          cfg,
+		httpServer:      httpServer,
+		router:          router,
+		whatsappService: whatsappService,
 	}
 
 	logger.Info().
@@ -103,8 +138,17 @@ func setupMiddleware(router chi.Router) {
 	router.Use(middleware.Compress(5))
 }
 
-// Start starts the HTTP server
+// Start starts the HTTP server, returning nil once it is shut down
 func (s *Server) Start() error {
+	err := s.listen()
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// listen serves HTTP or HTTPS depending on the configured certificates
+func (s *Server) listen() error {
 	logger.Info().
 		Str("address", s.httpServer.Addr).
 		Msg("Starting HTTP server")
@@ -118,8 +162,11 @@ func (s *Server) Start() error {
 	return s.httpServer.ListenAndServe()
 }
 
-// Shutdown gracefully shuts down the HTTP server
+// Shutdown gracefully shuts down the HTTP server and the WhatsApp sessions
 func (s *Server) Shutdown(ctx context.Context) error {
 	logger.Info().Msg("Shutting down HTTP server")
-	return s.httpServer.Shutdown(ctx)
+	err := s.httpServer.Shutdown(ctx)
+
+	s.whatsappService.Shutdown()
+	return err
 }
@@ -0,0 +1,74 @@
+package whatsapp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.mau.fi/whatsmeow/types"
+
+	"wazmeow/internal/domain/services"
+	"wazmeow/pkg/logger"
+)
+
+// RejectCall rejeita uma chamada recebida recentemente pela sessão
+func (s *Service) RejectCall(ctx context.Context, sessionID, callID, from string) error {
+	client, err := s.getLoggedInClient(sessionID)
+	if err != nil {
+		return err
+	}
+
+	return rejectTrackedCall(client, s.clientManager, sessionID, callID, from)
+}
+
+// callRejecter é a parte do cliente whatsmeow usada para rejeitar chamadas
+type callRejecter interface {
+	RejectCall(callFrom types.JID, callID string) error
+}
+
+// pendingCalls dá acesso às ofertas de chamada rastreadas pelo handler de eventos
+type pendingCalls interface {
+	PendingCall(sessionID, callID string) (types.JID, bool)
+	ForgetCall(sessionID, callID string)
+}
+
+// rejectTrackedCall rejeita uma chamada somente se ela foi oferecida recentemente por from
+func rejectTrackedCall(client callRejecter, calls pendingCalls, sessionID, callID, from string) error {
+	// Validar contra as ofertas de chamada rastreadas
+	caller, ok := calls.PendingCall(sessionID, callID)
+	if !ok {
+		return services.ErrCallNotFound
+	}
+
+	if from != "" {
+		fromJID, err := parseCallerJID(from)
+		if err != nil {
+			return err
+		}
+		if fromJID.User != caller.User {
+			return fmt.Errorf("call %s was not received from %s", callID, from)
+		}
+	}
+
+	if err := client.RejectCall(caller, callID); err != nil {
+		return fmt.Errorf("failed to reject call: %w", err)
+	}
+
+	calls.ForgetCall(sessionID, callID)
+
+	logger.Info().
+		Str("sessionID", sessionID).
+		Str("callID", callID).
+		Str("from", caller.String()).
+		Msg("Call rejected")
+	return nil
+}
+
+// parseCallerJID aceita um JID completo ou um número de telefone.
+// Sem "@" o types.ParseJID trata o texto todo como servidor, então números vão para parsePhoneJID.
+func parseCallerJID(from string) (types.JID, error) {
+	if !strings.Contains(from, "@") {
+		return parsePhoneJID(from)
+	}
+	return types.ParseJID(from)
+}
@@ -0,0 +1,88 @@
+package whatsapp
+
+import (
+	"errors"
+	"testing"
+
+	"go.mau.fi/whatsmeow/types"
+
+	"wazmeow/internal/domain/services"
+	"wazmeow/internal/infra/whatsapp/events"
+)
+
+// fakeRejecter registra as chamadas rejeitadas no lugar do cliente whatsmeow
+type fakeRejecter struct {
+	err      error
+	rejected []string
+	callers  []types.JID
+}
+
+func (f *fakeRejecter) RejectCall(callFrom types.JID, callID string) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.rejected = append(f.rejected, callID)
+	f.callers = append(f.callers, callFrom)
+	return nil
+}
+
+// trackerCalls expõe o CallTracker com os nomes usados pelo manager
+type trackerCalls struct {
+	*events.CallTracker
+}
+
+func (t trackerCalls) PendingCall(sessionID, callID string) (types.JID, bool) {
+	return t.Lookup(sessionID, callID)
+}
+
+func (t trackerCalls) ForgetCall(sessionID, callID string) {
+	t.Forget(sessionID, callID)
+}
+
+func TestRejectTrackedCall(t *testing.T) {
+	caller := types.NewADJID("5511888888888", 0, 3)
+	errSocket := errors.New("socket closed")
+
+	tests := []struct {
+		name       string
+		callID     string
+		from       string
+		rejectErr  error
+		wantReject bool
+		wantErr    error
+		wantKept   bool
+	}{
+		{name: "known call", callID: "call-1", wantReject: true},
+		{name: "known call from phone", callID: "call-1", from: "+55 11 88888-8888", wantReject: true},
+		{name: "known call from JID", callID: "call-1", from: "5511888888888@s.whatsapp.net", wantReject: true},
+		{name: "unknown call", callID: "call-2", wantErr: services.ErrCallNotFound, wantKept: true},
+		{name: "other caller", callID: "call-1", from: "5511777777777", wantKept: true},
+		{name: "reject error keeps call", callID: "call-1", rejectErr: errSocket, wantErr: errSocket, wantKept: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := trackerCalls{events.NewCallTracker()}
+			calls.Track("s1", "call-1", caller)
+			client := &fakeRejecter{err: tt.rejectErr}
+
+			err := rejectTrackedCall(client, calls, "s1", tt.callID, tt.from)
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("rejectTrackedCall() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantReject != (err == nil) {
+				t.Fatalf("rejectTrackedCall() error = %v, want rejected %v", err, tt.wantReject)
+			}
+
+			if tt.wantReject && (len(client.rejected) != 1 || client.rejected[0] != "call-1" || client.callers[0] != caller) {
+				t.Fatalf("rejected %v from %v, want call-1 from %s", client.rejected, client.callers, caller)
+			}
+			if !tt.wantReject && len(client.rejected) > 0 {
+				t.Fatalf("rejected %v, want nothing", client.rejected)
+			}
+			if _, kept := calls.PendingCall("s1", "call-1"); kept != tt.wantKept {
+				t.Fatalf("call-1 still tracked = %v, want %v", kept, tt.wantKept)
+			}
+		})
+	}
+}
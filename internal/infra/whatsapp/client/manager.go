@@ -2,13 +2,17 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"go.mau.fi/whatsmeow/store/sqlstore"
+	"go.mau.fi/whatsmeow/types"
 
 	"wazmeow/internal/config"
 	"wazmeow/internal/domain/repositories"
+	"wazmeow/internal/domain/services"
 	"wazmeow/internal/infra/whatsapp/events"
 	"wazmeow/internal/infra/whatsapp/qr"
 	"wazmeow/pkg/logger"
@@ -17,6 +21,7 @@ import (
 // Manager gerencia múltiplas sessões WhatsApp com performance otimizada
 type Manager struct {
 	clients      sync.Map // string -> *Wrapper (lock-free para leituras)
+	connectLocks sync.Map // string -> *sync.Mutex (serializa Connect por sessão)
 	factory      *Factory
 	pool         *Pool
 	eventHandler *events.Handler
@@ -26,6 +31,9 @@ type Manager struct {
 	ctx          context.Context
 	cancel       context.CancelFunc
 	wg           sync.WaitGroup
+
+	removedMu    sync.Mutex
+	removedHooks []func(sessionID string)
 }
 
 // NewManager cria um novo gerenciador otimizado
@@ -38,6 +46,11 @@ func NewManager(
 
 	factory := NewFactory(container, cfg)
 	pool := NewPool(cfg.PoolSize, cfg.PoolMaxIdle, cfg.PoolMaxLifetime)
+	historyLimits := events.HistoryLimits{
+		Events:     cfg.EventHistorySize,
+		Bytes:      cfg.EventHistoryBytes,
+		EventBytes: cfg.EventMaxBytes,
+	}
 
 	return &Manager{
 		factory:      factory,
@@ -46,7 +59,7 @@ func NewManager(
 		sessionRepo:  sessionRepo,
 		ctx:          ctx,
 		cancel:       cancel,
-		eventHandler: events.NewHandler(sessionRepo),
+		eventHandler: events.NewHandler(sessionRepo, historyLimits, cfg.WarmupTimeout),
 		qrProcessor:  qr.NewProcessor(sessionRepo, cfg),
 	}
 }
@@ -83,8 +96,7 @@ func (m *Manager) Create(ctx context.Context, sessionID string) error {
 		return fmt.Errorf("failed to create wrapper: %w", err)
 	}
 
-	// TODO: Configurar event handlers (implementar depois)
-	// m.eventHandler.Setup(wrapper.GetWrapperAdapter())
+	m.attachEventHandler(sessionID, wrapper)
 
 	// Armazenar no mapa
 	m.clients.Store(sessionID, wrapper)
@@ -100,6 +112,67 @@ func (m *Manager) Create(ctx context.Context, sessionID string) error {
 	return nil
 }
 
+// attachEventHandler liga o handler de eventos ao cliente whatsmeow da sessão.
+// Sem ele nenhum evento do cliente chega à aplicação: status de conexão, webhooks,
+// histórico, leitura e rejeição automáticas, prontidão e a checagem de pareamento
+// dependem desta chamada. LoadAll também passa por Create, então sessões restauradas
+// recebem os mesmos handlers.
+func (m *Manager) attachEventHandler(sessionID string, wrapper *Wrapper) {
+	m.eventHandler.Setup(wrapper.GetWrapperAdapter())
+
+	// Recusar pareamento com uma conta diferente do número reivindicado
+	wrapper.Client().PrePairCallback = m.eventHandler.PairGuard(sessionID)
+}
+
+// Connect conecta uma sessão: diretamente se já autenticada, ou iniciando o loop de QR
+func (m *Manager) Connect(ctx context.Context, sessionID string) error {
+	wrapper := m.Get(sessionID)
+	if wrapper == nil {
+		return fmt.Errorf("session %s not found", sessionID)
+	}
+	client := wrapper.Client()
+
+	unlock := m.lockConnect(sessionID)
+	defer unlock()
+
+	// Já autenticada: apenas conectar
+	if client.Store.ID != nil {
+		if client.IsConnected() {
+			return fmt.Errorf("session %s already connected", sessionID)
+		}
+		return client.Connect()
+	}
+
+	// Nova tentativa de pareamento
+	m.eventHandler.ClearPairRejection(sessionID)
+
+	// Registrar o novo loop antes da goroutine, encerrando (e aguardando) o anterior
+	loop, replaced := m.qrProcessor.Start(m.ctx, sessionID)
+	if replaced || client.IsConnected() {
+		client.Disconnect()
+	}
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		if err := m.qrProcessor.Process(loop, client, sessionID); err != nil && !errors.Is(err, qr.ErrQRCancelled) {
+			logger.Error().Str("sessionID", sessionID).Err(err).Msg("QR process failed")
+			client.Disconnect()
+		}
+	}()
+
+	logger.Info().Str("sessionID", sessionID).Int64("activeQRLoops", m.qrProcessor.ActiveLoops()).Msg("QR process started")
+	return nil
+}
+
+// lockConnect adquire o lock de conexão da sessão e retorna a função que o libera
+func (m *Manager) lockConnect(sessionID string) func() {
+	value, _ := m.connectLocks.LoadOrStore(sessionID, &sync.Mutex{})
+	mu := value.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
 // Remove remove uma sessão
 func (m *Manager) Remove(sessionID string) error {
 	wrapper := m.Get(sessionID)
@@ -108,13 +181,31 @@ func (m *Manager) Remove(sessionID string) error {
 	}
 
 	// Desconectar e limpar
+	m.qrProcessor.Cancel(sessionID)
+	m.discardEphemeralDevice(context.Background(), sessionID, wrapper)
 	wrapper.Disconnect()
+	m.eventHandler.Remove(sessionID)
 	m.clients.Delete(sessionID)
+	m.connectLocks.Delete(sessionID)
+
+	m.removedMu.Lock()
+	hooks := append([]func(string){}, m.removedHooks...)
+	m.removedMu.Unlock()
+	for _, hook := range hooks {
+		hook(sessionID)
+	}
 
 	logger.Info().Str("sessionID", sessionID).Msg("Session removed successfully")
 	return nil
 }
 
+// OnRemove registra uma função chamada após a remoção de cada sessão
+func (m *Manager) OnRemove(hook func(sessionID string)) {
+	m.removedMu.Lock()
+	defer m.removedMu.Unlock()
+	m.removedHooks = append(m.removedHooks, hook)
+}
+
 // Count retorna o número de sessões ativas
 func (m *Manager) Count() int {
 	count := 0
@@ -176,6 +267,7 @@ func (m *Manager) Shutdown(ctx context.Context) error {
 	// Desconectar todas as sessões
 	m.clients.Range(func(key, value interface{}) bool {
 		wrapper := value.(*Wrapper)
+		m.discardEphemeralDevice(ctx, key.(string), wrapper)
 		wrapper.Disconnect()
 		return true
 	})
@@ -200,6 +292,88 @@ func (m *Manager) Shutdown(ctx context.Context) error {
 	return nil
 }
 
+// discardEphemeralDevice remove o device whatsmeow de sessões não persistentes,
+// para que chaves e prekeys não fiquem no banco sem uma sessão apontando para elas
+func (m *Manager) discardEphemeralDevice(ctx context.Context, sessionID string, wrapper *Wrapper) {
+	client := wrapper.Client()
+	if client.Store.ID == nil {
+		return
+	}
+
+	session, err := m.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil || session == nil || session.Persistent {
+		return
+	}
+
+	// Logout desvincula o aparelho e apaga o device; offline, apenas apaga o device
+	if client.IsLoggedIn() {
+		err = client.Logout(ctx)
+	} else {
+		err = client.Store.Delete(ctx)
+	}
+	if err != nil {
+		logger.Error().Str("sessionID", sessionID).Err(err).Msg("Failed to discard non-persistent device")
+		return
+	}
+
+	logger.Info().Str("sessionID", sessionID).Msg("Non-persistent device discarded")
+}
+
+// PendingCall retorna o autor de uma oferta de chamada recente da sessão
+func (m *Manager) PendingCall(sessionID, callID string) (types.JID, bool) {
+	return m.eventHandler.PendingCall(sessionID, callID)
+}
+
+// ForgetCall remove uma chamada do rastreamento da sessão
+func (m *Manager) ForgetCall(sessionID, callID string) {
+	m.eventHandler.ForgetCall(sessionID, callID)
+}
+
+// EventHandlerIDs retorna os IDs dos event handlers registrados para uma sessão
+func (m *Manager) EventHandlerIDs(sessionID string) []uint32 {
+	return m.eventHandler.HandlerIDs(sessionID)
+}
+
+// ResetEventHandlers restaura o conjunto padrão de event handlers de uma sessão
+func (m *Manager) ResetEventHandlers(sessionID string) ([]uint32, bool) {
+	return m.eventHandler.ResetHandlers(sessionID)
+}
+
+// PairRejection retorna o erro do último pareamento recusado da sessão, se houver
+func (m *Manager) PairRejection(sessionID string) error {
+	return m.eventHandler.PairRejection(sessionID)
+}
+
+// ProfilePictureID retorna o ID em cache da foto de perfil da própria conta
+func (m *Manager) ProfilePictureID(sessionID string) (string, bool) {
+	return m.eventHandler.ProfilePictureID(sessionID)
+}
+
+// SetProfilePictureID atualiza o ID em cache da foto de perfil da própria conta
+func (m *Manager) SetProfilePictureID(sessionID, pictureID string) {
+	m.eventHandler.SetProfilePictureID(sessionID, pictureID)
+}
+
+// SetAutoMarkRead atualiza a flag de leitura automática em cache da sessão
+func (m *Manager) SetAutoMarkRead(sessionID string, enabled bool) {
+	m.eventHandler.SetAutoMarkRead(sessionID, enabled)
+}
+
+// SetAutoReject atualiza as configurações de rejeição automática em cache da sessão
+func (m *Manager) SetAutoReject(sessionID string, enabled bool, message string) {
+	m.eventHandler.SetAutoReject(sessionID, enabled, message)
+}
+
+// IsReady verifica se a sessão concluiu o warmup após conectar
+func (m *Manager) IsReady(sessionID string) bool {
+	return m.eventHandler.IsReady(sessionID)
+}
+
+// RecentEvents retorna os eventos recentes de uma sessão posteriores a since
+func (m *Manager) RecentEvents(sessionID string, since time.Time) []services.RecordedEvent {
+	return m.eventHandler.RecentEvents(sessionID, since)
+}
+
 // GetStats retorna estatísticas do manager
 func (m *Manager) GetStats() map[string]interface{} {
 	connected := 0
@@ -217,10 +391,11 @@ func (m *Manager) GetStats() map[string]interface{} {
 	})
 
 	return map[string]interface{}{
-		"total":       m.Count(),
-		"connected":   connected,
-		"loggedIn":    loggedIn,
-		"maxSessions": m.config.MaxSessions,
-		"poolStats":   m.pool.GetStats(),
+		"total":         m.Count(),
+		"connected":     connected,
+		"loggedIn":      loggedIn,
+		"maxSessions":   m.config.MaxSessions,
+		"activeQRLoops": m.qrProcessor.ActiveLoops(),
+		"poolStats":     m.pool.GetStats(),
 	}
 }
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// WhatsAppSender é o subconjunto do cliente whatsmeow usado para enviar mensagens e consultar grupos.
+// Código que envia ou consulta deve depender desta interface, e não de *whatsmeow.Client,
+// para poder ser testado sem conexão real com o WhatsApp.
+type WhatsAppSender interface {
+	Upload(ctx context.Context, plaintext []byte, appInfo whatsmeow.MediaType) (whatsmeow.UploadResponse, error)
+	SendMessage(ctx context.Context, to types.JID, message *waE2E.Message, extra ...whatsmeow.SendRequestExtra) (whatsmeow.SendResponse, error)
+	GenerateMessageID() types.MessageID
+	GetGroupInfo(jid types.JID) (*types.GroupInfo, error)
+	GetJoinedGroups() ([]*types.GroupInfo, error)
+}
+
+var _ WhatsAppSender = (*whatsmeow.Client)(nil)
+
+// Sender retorna o cliente da sessão como WhatsAppSender
+func (w *Wrapper) Sender() WhatsAppSender {
+	return w.client
+}
+
+// SendText envia uma mensagem de texto simples
+func SendText(ctx context.Context, sender WhatsAppSender, to types.JID, text string) (whatsmeow.SendResponse, error) {
+	return sender.SendMessage(ctx, to, &waE2E.Message{Conversation: proto.String(text)})
+}
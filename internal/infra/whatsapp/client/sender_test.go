@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// mockSender registra as mensagens enviadas no lugar do cliente whatsmeow
+type mockSender struct {
+	WhatsAppSender
+	sendErr error
+	sentTo  []types.JID
+	sent    []*waE2E.Message
+}
+
+func (m *mockSender) SendMessage(_ context.Context, to types.JID, message *waE2E.Message, _ ...whatsmeow.SendRequestExtra) (whatsmeow.SendResponse, error) {
+	if m.sendErr != nil {
+		return whatsmeow.SendResponse{}, m.sendErr
+	}
+	m.sentTo = append(m.sentTo, to)
+	m.sent = append(m.sent, message)
+	return whatsmeow.SendResponse{ID: "msg-1"}, nil
+}
+
+func TestSendText(t *testing.T) {
+	to := types.NewJID("5511888888888", types.DefaultUserServer)
+	errSend := errors.New("not connected")
+
+	tests := []struct {
+		name    string
+		text    string
+		sendErr error
+	}{
+		{name: "sent as conversation", text: "hello"},
+		{name: "send error returned", text: "hello", sendErr: errSend},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sender := &mockSender{sendErr: tt.sendErr}
+
+			resp, err := SendText(context.Background(), sender, to, tt.text)
+			if !errors.Is(err, tt.sendErr) {
+				t.Fatalf("SendText() error = %v, want %v", err, tt.sendErr)
+			}
+			if tt.sendErr != nil {
+				return
+			}
+
+			if resp.ID != "msg-1" {
+				t.Fatalf("response ID = %q, want msg-1", resp.ID)
+			}
+			if len(sender.sent) != 1 || sender.sentTo[0] != to || sender.sent[0].GetConversation() != tt.text {
+				t.Fatalf("sent %v to %v, want %q to %v", sender.sent, sender.sentTo, tt.text, to)
+			}
+		})
+	}
+}
@@ -3,11 +3,13 @@ package client
 import (
 	"context"
 	"sync/atomic"
+	"time"
 
 	"go.mau.fi/whatsmeow"
 	"go.mau.fi/whatsmeow/types"
 
 	"wazmeow/internal/domain/entities"
+	"wazmeow/internal/infra/whatsapp/events"
 )
 
 // Wrapper encapsula um cliente WhatsApp com estado thread-safe otimizado
@@ -111,6 +113,34 @@ func (w *Wrapper) SetStatus(status entities.SessionStatus) {
 	atomic.StoreInt32(&w.state.status, val)
 }
 
+// verboseLogger define um logger whatsmeow com verbosidade ajustável
+type verboseLogger interface {
+	SetVerbose(verbose bool)
+	IsVerbose() bool
+}
+
+// SetDebug ativa ou desativa o log verboso do whatsmeow apenas para esta sessão
+func (w *Wrapper) SetDebug(enabled bool) bool {
+	if w.client == nil {
+		return false
+	}
+	log, ok := w.client.Log.(verboseLogger)
+	if !ok {
+		return false
+	}
+	log.SetVerbose(enabled)
+	return true
+}
+
+// IsDebug verifica se o log verboso está ativo para esta sessão
+func (w *Wrapper) IsDebug() bool {
+	if w.client == nil {
+		return false
+	}
+	log, ok := w.client.Log.(verboseLogger)
+	return ok && log.IsVerbose()
+}
+
 // Disconnect desconecta o cliente e cancela o context
 func (w *Wrapper) Disconnect() {
 	if w.client != nil && w.client.IsConnected() {
@@ -129,8 +159,47 @@ type ClientAdapter struct {
 }
 
 // AddEventHandler implementa a interface ClientInterface
-func (ca *ClientAdapter) AddEventHandler(handler func(interface{})) {
-	ca.client.AddEventHandler(handler)
+func (ca *ClientAdapter) AddEventHandler(handler func(interface{})) uint32 {
+	return ca.client.AddEventHandler(handler)
+}
+
+// RemoveEventHandler implementa a interface ClientInterface
+func (ca *ClientAdapter) RemoveEventHandler(id uint32) bool {
+	return ca.client.RemoveEventHandler(id)
+}
+
+// MarkRead implementa a interface ClientInterface
+func (ca *ClientAdapter) MarkRead(ids []types.MessageID, timestamp time.Time, chat, sender types.JID, receiptTypeExtra ...types.ReceiptType) error {
+	return ca.client.MarkRead(ids, timestamp, chat, sender, receiptTypeExtra...)
+}
+
+// GetPrivacySettings implementa a interface ClientInterface
+func (ca *ClientAdapter) GetPrivacySettings(ctx context.Context) types.PrivacySettings {
+	return ca.client.GetPrivacySettings(ctx)
+}
+
+// RejectCall implementa a interface ClientInterface
+func (ca *ClientAdapter) RejectCall(callFrom types.JID, callID string) error {
+	return ca.client.RejectCall(callFrom, callID)
+}
+
+// SendText implementa a interface ClientInterface
+func (ca *ClientAdapter) SendText(ctx context.Context, to types.JID, text string) error {
+	_, err := SendText(ctx, ca.client, to, text)
+	return err
+}
+
+// OwnJID implementa a interface ClientInterface
+func (ca *ClientAdapter) OwnJID() types.JID {
+	if ca.client.Store.ID == nil {
+		return types.EmptyJID
+	}
+	return *ca.client.Store.ID
+}
+
+// OwnLID implementa a interface ClientInterface
+func (ca *ClientAdapter) OwnLID() types.JID {
+	return ca.client.Store.GetLID()
 }
 
 // GetClientAdapter retorna um adapter para eventos
@@ -149,7 +218,7 @@ func (wa *WrapperAdapter) SessionID() string {
 }
 
 // Client implementa WrapperInterface
-func (wa *WrapperAdapter) Client() *ClientAdapter {
+func (wa *WrapperAdapter) Client() events.ClientInterface {
 	return wa.wrapper.GetClientAdapter()
 }
 
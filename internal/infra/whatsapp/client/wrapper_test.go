@@ -0,0 +1,79 @@
+package client
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"go.mau.fi/whatsmeow"
+	waLog "go.mau.fi/whatsmeow/util/log"
+
+	"wazmeow/pkg/logger"
+)
+
+// captureLogs redireciona o logger global para um buffer no nível info
+func captureLogs(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	origLogger, origLevel := log.Logger, zerolog.GlobalLevel()
+	t.Cleanup(func() {
+		log.Logger = origLogger
+		zerolog.SetGlobalLevel(origLevel)
+	})
+
+	var buf bytes.Buffer
+	log.Logger = zerolog.New(&buf)
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	return &buf
+}
+
+func TestWrapperSetDebug(t *testing.T) {
+	tests := []struct {
+		name        string
+		client      *whatsmeow.Client
+		enable      bool
+		wantApplied bool
+		wantDebug   bool
+		wantLogged  bool
+	}{
+		{name: "enabled", client: &whatsmeow.Client{Log: logger.NewWALogger("s1")}, enable: true, wantApplied: true, wantDebug: true, wantLogged: true},
+		{name: "disabled", client: &whatsmeow.Client{Log: logger.NewWALogger("s1")}, enable: false, wantApplied: true},
+		{name: "logger without verbosity", client: &whatsmeow.Client{Log: waLog.Noop}, enable: true},
+		{name: "no client", enable: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := captureLogs(t)
+			w := NewWrapper(tt.client, "s1", nil)
+
+			if applied := w.SetDebug(tt.enable); applied != tt.wantApplied {
+				t.Fatalf("SetDebug(%v) = %v, want %v", tt.enable, applied, tt.wantApplied)
+			}
+			if w.IsDebug() != tt.wantDebug {
+				t.Fatalf("IsDebug() = %v, want %v", w.IsDebug(), tt.wantDebug)
+			}
+			if tt.client == nil {
+				return
+			}
+
+			// Os sub-loggers criados pelo whatsmeow compartilham a verbosidade do logger do cliente
+			tt.client.Log.Sub("Socket").Debugf("frame received")
+			if logged := strings.Contains(buf.String(), "frame received"); logged != tt.wantLogged {
+				t.Fatalf("debug message logged = %v, want %v: %s", logged, tt.wantLogged, buf.String())
+			}
+		})
+	}
+}
+
+func TestWrapperSetDebugToggle(t *testing.T) {
+	w := NewWrapper(&whatsmeow.Client{Log: logger.NewWALogger("s1")}, "s1", nil)
+
+	for _, enabled := range []bool{true, false, true} {
+		w.SetDebug(enabled)
+		if w.IsDebug() != enabled {
+			t.Fatalf("IsDebug() = %v after SetDebug(%v)", w.IsDebug(), enabled)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package events
+
+import (
+	"context"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"wazmeow/pkg/logger"
+)
+
+// isAutoReadCandidate verifica se a mensagem pode receber confirmação de leitura automática
+func isAutoReadCandidate(evt *events.Message) bool {
+	// Mensagens próprias nunca são marcadas
+	if evt.Info.IsFromMe {
+		return false
+	}
+
+	// Status, broadcasts, newsletters e grupos (volume alto, ruído para bots) não são marcados
+	switch evt.Info.Chat.Server {
+	case types.BroadcastServer, types.NewsletterServer, types.GroupServer:
+		return false
+	}
+
+	// Ruído de protocolo (edições, revogações, sync de chaves) e reações
+	if evt.Message == nil || evt.Message.GetProtocolMessage() != nil || evt.Message.GetReactionMessage() != nil {
+		return false
+	}
+
+	return true
+}
+
+// SetAutoMarkRead atualiza a flag de leitura automática em cache da sessão
+func (h *Handler) SetAutoMarkRead(sessionID string, enabled bool) {
+	h.autoRead.Store(sessionID, enabled)
+}
+
+// cachedAutoMarkRead retorna a flag em cache da sessão, se já carregada
+func (h *Handler) cachedAutoMarkRead(sessionID string) (enabled, cached bool) {
+	value, ok := h.autoRead.Load(sessionID)
+	if !ok {
+		return false, false
+	}
+	return value.(bool), true
+}
+
+// loadAutoMarkRead carrega a flag do banco na primeira mensagem da sessão e a mantém em cache
+func (h *Handler) loadAutoMarkRead(ctx context.Context, sessionID string) bool {
+	if enabled, cached := h.cachedAutoMarkRead(sessionID); cached {
+		return enabled
+	}
+
+	session, err := h.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil || session == nil {
+		return false
+	}
+
+	// Não sobrescrever um valor definido via SetAutoMarkRead durante a consulta
+	value, _ := h.autoRead.LoadOrStore(sessionID, session.AutoMarkRead)
+	return value.(bool)
+}
+
+// autoMarkRead envia confirmação de leitura quando habilitado na sessão
+func (h *Handler) autoMarkRead(sessionID string, evt *events.Message) {
+	ctx := context.Background()
+
+	if !h.loadAutoMarkRead(ctx, sessionID) {
+		return
+	}
+
+	client := h.getClient(sessionID)
+	if client == nil {
+		return
+	}
+
+	// Respeitar a configuração de privacidade de confirmações de leitura (em cache no whatsmeow)
+	if client.GetPrivacySettings(ctx).ReadReceipts == types.PrivacySettingNone {
+		logger.Debug().Str("sessionID", sessionID).Msg("Read receipts disabled in privacy settings, skipping auto mark read")
+		return
+	}
+
+	if err := client.MarkRead([]types.MessageID{evt.Info.ID}, evt.Info.Timestamp, evt.Info.Chat, evt.Info.Sender); err != nil {
+		logger.Error().
+			Str("sessionID", sessionID).
+			Str("messageID", evt.Info.ID).
+			Err(err).
+			Msg("Failed to auto mark message as read")
+		return
+	}
+
+	logger.Debug().
+		Str("sessionID", sessionID).
+		Str("messageID", evt.Info.ID).
+		Msg("Message auto marked as read")
+}
@@ -0,0 +1,102 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	"google.golang.org/protobuf/proto"
+
+	"wazmeow/internal/domain/entities"
+)
+
+// newTestMessage cria uma mensagem de texto recebida no chat informado
+func newTestMessage(chat types.JID, fromMe bool, msg *waE2E.Message) *events.Message {
+	return &events.Message{
+		Info: types.MessageInfo{
+			MessageSource: types.MessageSource{
+				Chat:     chat,
+				Sender:   chat,
+				IsFromMe: fromMe,
+			},
+			ID:        "msg-1",
+			Timestamp: time.Now(),
+		},
+		Message: msg,
+	}
+}
+
+func TestIsAutoReadCandidate(t *testing.T) {
+	user := types.NewJID("5511888888888", types.DefaultUserServer)
+	text := &waE2E.Message{Conversation: proto.String("hi")}
+
+	tests := []struct {
+		name string
+		evt  *events.Message
+		want bool
+	}{
+		{name: "direct text", evt: newTestMessage(user, false, text), want: true},
+		{name: "own message", evt: newTestMessage(user, true, text)},
+		{name: "group", evt: newTestMessage(types.NewJID("120363000000000000", types.GroupServer), false, text)},
+		{name: "status broadcast", evt: newTestMessage(types.StatusBroadcastJID, false, text)},
+		{name: "newsletter", evt: newTestMessage(types.NewJID("120363000000000000", types.NewsletterServer), false, text)},
+		{name: "empty message", evt: newTestMessage(user, false, nil)},
+		{name: "protocol message", evt: newTestMessage(user, false, &waE2E.Message{ProtocolMessage: &waE2E.ProtocolMessage{}})},
+		{name: "reaction", evt: newTestMessage(user, false, &waE2E.Message{ReactionMessage: &waE2E.ReactionMessage{}})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isAutoReadCandidate(tt.evt); got != tt.want {
+				t.Fatalf("isAutoReadCandidate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAutoMarkRead(t *testing.T) {
+	tests := []struct {
+		name       string
+		stored     bool
+		override   *bool
+		readable   bool
+		wantMarked bool
+		wantGets   int
+	}{
+		{name: "enabled", stored: true, readable: true, wantMarked: true, wantGets: 1},
+		{name: "disabled", stored: false, readable: true, wantGets: 1},
+		{name: "read receipts off in privacy", stored: true, readable: false, wantGets: 1},
+		{name: "enabled by set without database", stored: false, override: proto.Bool(true), readable: true, wantMarked: true},
+		{name: "disabled by set without database", stored: true, override: proto.Bool(false), readable: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := entities.NewSession("reader")
+			session.ID = "s1"
+			session.AutoMarkRead = tt.stored
+			repo := newFakeSessionRepo(session)
+
+			h, client := newTestHandler(repo, time.Hour, "s1")
+			client.readable = tt.readable
+			if tt.override != nil {
+				h.SetAutoMarkRead("s1", *tt.override)
+			}
+
+			evt := newTestMessage(types.NewJID("5511888888888", types.DefaultUserServer), false, &waE2E.Message{Conversation: proto.String("hi")})
+			// A flag é carregada do banco uma única vez
+			h.autoMarkRead("s1", evt)
+			h.autoMarkRead("s1", evt)
+
+			marked, _, _ := client.calls()
+			if got := len(marked) > 0; got != tt.wantMarked {
+				t.Fatalf("marked = %v, want %v", marked, tt.wantMarked)
+			}
+			if gets := repo.getCount(); gets != tt.wantGets {
+				t.Fatalf("database lookups = %d, want %d", gets, tt.wantGets)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package events
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.mau.fi/whatsmeow/types/events"
+
+	"wazmeow/pkg/logger"
+)
+
+// autoRejectPhonePlaceholder é substituído pelo número de quem ligou na mensagem configurada
+const autoRejectPhonePlaceholder = "{phone}"
+
+// CallAutoRejected é despachado quando uma chamada é rejeitada automaticamente
+type CallAutoRejected struct {
+	CallID      string    `json:"callID"`
+	From        string    `json:"from"`
+	MessageSent bool      `json:"messageSent"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+// autoRejectSettings são as configurações de rejeição automática em cache de uma sessão
+type autoRejectSettings struct {
+	enabled bool
+	message string
+}
+
+// SetAutoReject atualiza as configurações de rejeição automática em cache da sessão
+func (h *Handler) SetAutoReject(sessionID string, enabled bool, message string) {
+	h.autoReject.Store(sessionID, autoRejectSettings{enabled: enabled, message: message})
+}
+
+// cachedAutoReject retorna as configurações em cache da sessão, se já carregadas
+func (h *Handler) cachedAutoReject(sessionID string) (settings autoRejectSettings, cached bool) {
+	value, ok := h.autoReject.Load(sessionID)
+	if !ok {
+		return autoRejectSettings{}, false
+	}
+	return value.(autoRejectSettings), true
+}
+
+// loadAutoReject carrega as configurações do banco na primeira chamada da sessão e as mantém em cache
+func (h *Handler) loadAutoReject(ctx context.Context, sessionID string) autoRejectSettings {
+	if settings, cached := h.cachedAutoReject(sessionID); cached {
+		return settings
+	}
+
+	session, err := h.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil || session == nil {
+		return autoRejectSettings{}
+	}
+
+	// Não sobrescrever um valor definido via SetAutoReject durante a consulta
+	value, _ := h.autoReject.LoadOrStore(sessionID, autoRejectSettings{
+		enabled: session.AutoRejectCalls,
+		message: session.AutoRejectMessage,
+	})
+	return value.(autoRejectSettings)
+}
+
+// autoRejectCall rejeita a chamada quando habilitado na sessão e opcionalmente avisa quem ligou
+func (h *Handler) autoRejectCall(sessionID string, evt *events.CallOffer) {
+	ctx := context.Background()
+
+	settings := h.loadAutoReject(ctx, sessionID)
+	if !settings.enabled {
+		return
+	}
+
+	client := h.getClient(sessionID)
+	if client == nil {
+		return
+	}
+
+	if err := client.RejectCall(evt.From, evt.CallID); err != nil {
+		logger.Error().
+			Str("sessionID", sessionID).
+			Str("callID", evt.CallID).
+			Err(err).
+			Msg("Failed to auto reject call")
+		return
+	}
+	h.calls.Forget(sessionID, evt.CallID)
+
+	result := CallAutoRejected{
+		CallID:    evt.CallID,
+		From:      evt.From.ToNonAD().String(),
+		Timestamp: time.Now(),
+	}
+
+	if settings.message != "" {
+		text := strings.ReplaceAll(settings.message, autoRejectPhonePlaceholder, evt.From.User)
+		if err := client.SendText(ctx, evt.From.ToNonAD(), text); err != nil {
+			logger.Warn().
+				Str("sessionID", sessionID).
+				Str("callID", evt.CallID).
+				Err(err).
+				Msg("Failed to send auto reject message")
+		} else {
+			result.MessageSent = true
+		}
+	}
+
+	logger.Info().
+		Str("sessionID", sessionID).
+		Str("callID", evt.CallID).
+		Bool("messageSent", result.MessageSent).
+		Msg("📵 Call auto rejected")
+
+	h.dispatcher.Dispatch(sessionID, EventCallAutoRejected, &result)
+}
@@ -0,0 +1,74 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"wazmeow/internal/domain/entities"
+)
+
+func TestAutoRejectCall(t *testing.T) {
+	type override struct {
+		enabled bool
+		message string
+	}
+
+	tests := []struct {
+		name         string
+		enabled      bool
+		message      string
+		override     *override
+		wantRejected bool
+		wantText     string
+		wantGets     int
+	}{
+		{name: "disabled", wantGets: 1},
+		{name: "enabled without message", enabled: true, wantRejected: true, wantGets: 1},
+		{name: "enabled with message", enabled: true, message: "Call {phone} later", wantRejected: true, wantText: "Call 5511888888888 later", wantGets: 1},
+		{name: "disabled by set without database", enabled: true, override: &override{enabled: false}},
+		{name: "enabled by set without database", override: &override{enabled: true, message: "busy"}, wantRejected: true, wantText: "busy"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := entities.NewSession("rejecter")
+			session.ID = "s1"
+			session.SetAutoRejectCalls(tt.enabled, tt.message)
+			repo := newFakeSessionRepo(session)
+
+			h, client := newTestHandler(repo, time.Hour, "s1")
+			recorder := subscribeAll(h)
+			if tt.override != nil {
+				h.SetAutoReject("s1", tt.override.enabled, tt.override.message)
+			}
+
+			caller := types.NewADJID("5511888888888", 0, 3)
+			// As configurações são carregadas do banco uma única vez
+			for _, callID := range []string{"call-1", "call-2"} {
+				h.autoRejectCall("s1", &events.CallOffer{BasicCallMeta: types.BasicCallMeta{From: caller, CallID: callID}})
+			}
+
+			_, rejected, sentTexts := client.calls()
+			if got := len(rejected) == 2; got != tt.wantRejected {
+				t.Fatalf("rejected = %v, want rejected %v", rejected, tt.wantRejected)
+			}
+			if tt.wantText == "" && len(sentTexts) > 0 {
+				t.Fatalf("sent texts = %v, want none", sentTexts)
+			}
+			if tt.wantText != "" && (len(sentTexts) != 2 || sentTexts[0] != tt.wantText) {
+				t.Fatalf("sent texts = %v, want %q", sentTexts, tt.wantText)
+			}
+			if gets := repo.getCount(); gets != tt.wantGets {
+				t.Fatalf("database lookups = %d, want %d", gets, tt.wantGets)
+			}
+			if tt.wantRejected {
+				if _, ok := recorder.waitFor(EventCallAutoRejected); !ok {
+					t.Fatalf("call_auto_rejected event not dispatched")
+				}
+			}
+		})
+	}
+}
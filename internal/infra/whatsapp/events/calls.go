@@ -0,0 +1,78 @@
+package events
+
+import (
+	"sync"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// callTTL é o tempo máximo que uma oferta de chamada permanece rastreada
+const callTTL = 5 * time.Minute
+
+// CallTracker rastreia ofertas de chamada recentes por sessão
+type CallTracker struct {
+	calls map[string]map[string]trackedCall // sessionID -> callID -> chamada
+	mu    sync.Mutex
+}
+
+// trackedCall representa uma oferta de chamada recebida
+type trackedCall struct {
+	from       types.JID
+	receivedAt time.Time
+}
+
+// NewCallTracker cria um novo rastreador de chamadas
+func NewCallTracker() *CallTracker {
+	return &CallTracker{
+		calls: make(map[string]map[string]trackedCall),
+	}
+}
+
+// Track registra uma oferta de chamada
+func (t *CallTracker) Track(sessionID, callID string, from types.JID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	sessionCalls, ok := t.calls[sessionID]
+	if !ok {
+		sessionCalls = make(map[string]trackedCall)
+		t.calls[sessionID] = sessionCalls
+	}
+
+	// Remover chamadas expiradas
+	now := time.Now()
+	for id, call := range sessionCalls {
+		if now.Sub(call.receivedAt) > callTTL {
+			delete(sessionCalls, id)
+		}
+	}
+
+	sessionCalls[callID] = trackedCall{from: from, receivedAt: now}
+}
+
+// Lookup retorna o autor de uma chamada rastreada e ainda válida
+func (t *CallTracker) Lookup(sessionID, callID string) (types.JID, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	call, ok := t.calls[sessionID][callID]
+	if !ok || time.Since(call.receivedAt) > callTTL {
+		return types.EmptyJID, false
+	}
+	return call.from, true
+}
+
+// Forget remove uma chamada rastreada
+func (t *CallTracker) Forget(sessionID, callID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.calls[sessionID], callID)
+}
+
+// Clear remove todas as chamadas rastreadas de uma sessão
+func (t *CallTracker) Clear(sessionID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.calls, sessionID)
+}
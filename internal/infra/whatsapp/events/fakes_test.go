@@ -0,0 +1,269 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+
+	"wazmeow/internal/domain/entities"
+)
+
+// fakeSessionRepo é um repositório de sessões em memória para testes
+type fakeSessionRepo struct {
+	mu       sync.Mutex
+	sessions map[string]*entities.Session
+	err      error // retornado por GetByID quando definido
+	gets     int
+}
+
+func newFakeSessionRepo(sessions ...*entities.Session) *fakeSessionRepo {
+	repo := &fakeSessionRepo{sessions: make(map[string]*entities.Session)}
+	for _, session := range sessions {
+		repo.sessions[session.ID] = session
+	}
+	return repo
+}
+
+func (r *fakeSessionRepo) Create(_ context.Context, session *entities.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sessions[session.ID] = session
+	return nil
+}
+
+func (r *fakeSessionRepo) GetByID(_ context.Context, id string) (*entities.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.gets++
+	if r.err != nil {
+		return nil, r.err
+	}
+	session, ok := r.sessions[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *session
+	return &copied, nil
+}
+
+func (r *fakeSessionRepo) GetByName(context.Context, string) (*entities.Session, error) {
+	return nil, nil
+}
+
+func (r *fakeSessionRepo) GetByDeviceJID(context.Context, string) (*entities.Session, error) {
+	return nil, nil
+}
+
+func (r *fakeSessionRepo) GetAll(context.Context) ([]*entities.Session, error) {
+	return nil, nil
+}
+
+func (r *fakeSessionRepo) GetConnectedSessions(context.Context) ([]*entities.Session, error) {
+	return nil, nil
+}
+
+func (r *fakeSessionRepo) Update(_ context.Context, session *entities.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sessions[session.ID] = session
+	return nil
+}
+
+func (r *fakeSessionRepo) Delete(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.sessions, id)
+	return nil
+}
+
+func (r *fakeSessionRepo) UpdateStatus(_ context.Context, id string, status entities.SessionStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if session, ok := r.sessions[id]; ok {
+		session.Status = status
+	}
+	return nil
+}
+
+func (r *fakeSessionRepo) status(id string) entities.SessionStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if session, ok := r.sessions[id]; ok {
+		return session.Status
+	}
+	return ""
+}
+
+func (r *fakeSessionRepo) getCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.gets
+}
+
+// fakeClient implementa ClientInterface registrando as chamadas recebidas
+type fakeClient struct {
+	mu        sync.Mutex
+	ownJID    types.JID
+	ownLID    types.JID
+	readable  bool
+	handlers  map[uint32]func(interface{})
+	nextID    uint32
+	marked    []types.MessageID
+	rejected  []string
+	sentTexts []string
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		ownJID:   types.NewJID("5511999999999", types.DefaultUserServer),
+		ownLID:   types.NewJID("123456789", types.HiddenUserServer),
+		readable: true,
+		handlers: make(map[uint32]func(interface{})),
+	}
+}
+
+func (c *fakeClient) AddEventHandler(handler func(interface{})) uint32 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nextID++
+	c.handlers[c.nextID] = handler
+	return c.nextID
+}
+
+func (c *fakeClient) RemoveEventHandler(id uint32) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.handlers[id]
+	delete(c.handlers, id)
+	return ok
+}
+
+func (c *fakeClient) MarkRead(ids []types.MessageID, _ time.Time, _, _ types.JID, _ ...types.ReceiptType) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.marked = append(c.marked, ids...)
+	return nil
+}
+
+func (c *fakeClient) GetPrivacySettings(context.Context) types.PrivacySettings {
+	if !c.readable {
+		return types.PrivacySettings{ReadReceipts: types.PrivacySettingNone}
+	}
+	return types.PrivacySettings{ReadReceipts: types.PrivacySettingAll}
+}
+
+func (c *fakeClient) RejectCall(_ types.JID, callID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rejected = append(c.rejected, callID)
+	return nil
+}
+
+func (c *fakeClient) SendText(_ context.Context, _ types.JID, text string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sentTexts = append(c.sentTexts, text)
+	return nil
+}
+
+func (c *fakeClient) OwnJID() types.JID {
+	return c.ownJID
+}
+
+func (c *fakeClient) OwnLID() types.JID {
+	return c.ownLID
+}
+
+func (c *fakeClient) handlerCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.handlers)
+}
+
+func (c *fakeClient) calls() (marked []types.MessageID, rejected, sentTexts []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]types.MessageID(nil), c.marked...), append([]string(nil), c.rejected...), append([]string(nil), c.sentTexts...)
+}
+
+// fakeWrapper implementa WrapperInterface sobre um fakeClient
+type fakeWrapper struct {
+	sessionID string
+	client    *fakeClient
+}
+
+func (w *fakeWrapper) SessionID() string {
+	return w.sessionID
+}
+
+func (w *fakeWrapper) Client() ClientInterface {
+	return w.client
+}
+
+// recordedDispatch é um evento despachado capturado por subscribeAll
+type recordedDispatch struct {
+	sessionID string
+	eventType string
+	data      interface{}
+}
+
+// dispatchRecorder captura os eventos despachados pelo handler
+type dispatchRecorder struct {
+	mu     sync.Mutex
+	events []recordedDispatch
+}
+
+// subscribeAll inscreve o recorder em todos os nomes de evento do registry
+func subscribeAll(h *Handler) *dispatchRecorder {
+	recorder := &dispatchRecorder{}
+	for _, info := range Registry() {
+		h.dispatcher.Subscribe(info.Name, func(sessionID, eventType string, data interface{}) {
+			recorder.mu.Lock()
+			defer recorder.mu.Unlock()
+			recorder.events = append(recorder.events, recordedDispatch{sessionID, eventType, data})
+		})
+	}
+	return recorder
+}
+
+// waitFor aguarda até que um evento do tipo informado seja despachado
+func (r *dispatchRecorder) waitFor(eventType string) (recordedDispatch, bool) {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		r.mu.Lock()
+		for _, evt := range r.events {
+			if evt.eventType == eventType {
+				r.mu.Unlock()
+				return evt, true
+			}
+		}
+		r.mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+	}
+	return recordedDispatch{}, false
+}
+
+// eventTypes retorna os tipos despachados até agora
+func (r *dispatchRecorder) eventTypes() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	result := make([]string, 0, len(r.events))
+	for _, evt := range r.events {
+		result = append(result, evt.eventType)
+	}
+	return result
+}
+
+// errDB simula uma falha transitória do banco
+var errDB = errors.New("connection refused")
+
+// newTestHandler cria um handler com o cliente fake registrado para a sessão
+func newTestHandler(repo *fakeSessionRepo, warmup time.Duration, sessionID string) (*Handler, *fakeClient) {
+	h := NewHandler(repo, HistoryLimits{Events: 10}, warmup)
+	client := newFakeClient()
+	h.Setup(&fakeWrapper{sessionID: sessionID, client: client})
+	return h, client
+}
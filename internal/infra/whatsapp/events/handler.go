@@ -2,11 +2,18 @@ package events
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
 
+	"go.mau.fi/whatsmeow/types"
 	"go.mau.fi/whatsmeow/types/events"
 
 	"wazmeow/internal/domain/entities"
 	"wazmeow/internal/domain/repositories"
+	"wazmeow/internal/domain/services"
 	"wazmeow/pkg/logger"
 )
 
@@ -14,15 +21,30 @@ import (
 type Handler struct {
 	dispatcher  *Dispatcher
 	logger      *Logger
+	history     *History
+	calls       *CallTracker
+	readiness   *Readiness
 	sessionRepo repositories.SessionRepository
+	clients     sync.Map // string -> ClientInterface
+	pictures    sync.Map // string -> ID da foto de perfil da própria conta
+	autoRead    sync.Map // string -> bool (flag AutoMarkRead em cache)
+	autoReject  sync.Map // string -> autoRejectSettings (em cache)
+	rejections  sync.Map // string -> error (último pareamento recusado pelo PairGuard)
+
+	handlersMu sync.Mutex
+	handlerIDs map[string][]uint32
 }
 
 // NewHandler cria um novo handler de eventos
-func NewHandler(sessionRepo repositories.SessionRepository) *Handler {
+func NewHandler(sessionRepo repositories.SessionRepository, historyLimits HistoryLimits, warmupTimeout time.Duration) *Handler {
 	return &Handler{
 		dispatcher:  NewDispatcher(),
 		logger:      NewLogger(),
+		history:     NewHistory(historyLimits),
+		calls:       NewCallTracker(),
+		readiness:   NewReadiness(warmupTimeout),
 		sessionRepo: sessionRepo,
+		handlerIDs:  make(map[string][]uint32),
 	}
 }
 
@@ -31,12 +53,107 @@ func (h *Handler) Setup(wrapper WrapperInterface) {
 	client := wrapper.Client()
 	sessionID := wrapper.SessionID()
 
-	// Registrar handler principal
-	client.AddEventHandler(func(evt interface{}) {
+	previous, _ := h.clients.Swap(sessionID, client)
+
+	h.handlersMu.Lock()
+	defer h.handlersMu.Unlock()
+
+	if ids := h.handlerIDs[sessionID]; len(ids) > 0 {
+		logger.Warn().
+			Str("sessionID", sessionID).
+			Int("existing", len(ids)).
+			Msg("Event handlers already registered for session, replacing")
+
+		// No mesmo cliente os handlers antigos continuariam ativos e duplicariam os eventos
+		if previous == client {
+			for _, id := range ids {
+				client.RemoveEventHandler(id)
+			}
+		}
+	}
+	h.handlerIDs[sessionID] = []uint32{h.register(sessionID, client)}
+
+	logger.Info().Str("sessionID", sessionID).Msg("Event handlers configured")
+}
+
+// register adiciona o handler principal ao cliente e retorna seu ID
+func (h *Handler) register(sessionID string, client ClientInterface) uint32 {
+	return client.AddEventHandler(func(evt interface{}) {
 		h.handleEvent(sessionID, evt)
 	})
+}
 
-	logger.Info().Str("sessionID", sessionID).Msg("Event handlers configured")
+// HandlerIDs retorna os IDs dos event handlers registrados para uma sessão
+func (h *Handler) HandlerIDs(sessionID string) []uint32 {
+	h.handlersMu.Lock()
+	defer h.handlersMu.Unlock()
+
+	ids := make([]uint32, len(h.handlerIDs[sessionID]))
+	copy(ids, h.handlerIDs[sessionID])
+	return ids
+}
+
+// ResetHandlers remove os event handlers registrados por este handler e registra novamente o
+// conjunto padrão, preservando handlers internos do whatsmeow (ex: o canal de QR em uso)
+func (h *Handler) ResetHandlers(sessionID string) ([]uint32, bool) {
+	client := h.getClient(sessionID)
+	if client == nil {
+		return nil, false
+	}
+
+	h.handlersMu.Lock()
+	defer h.handlersMu.Unlock()
+
+	for _, id := range h.handlerIDs[sessionID] {
+		if !client.RemoveEventHandler(id) {
+			logger.Warn().Str("sessionID", sessionID).Uint32("handlerID", id).Msg("Event handler already removed")
+		}
+	}
+	h.handlerIDs[sessionID] = []uint32{h.register(sessionID, client)}
+
+	logger.Info().Str("sessionID", sessionID).Msg("Event handlers reset to default")
+
+	ids := make([]uint32, len(h.handlerIDs[sessionID]))
+	copy(ids, h.handlerIDs[sessionID])
+	return ids, true
+}
+
+// Remove remove as referências mantidas para uma sessão
+func (h *Handler) Remove(sessionID string) {
+	h.clients.Delete(sessionID)
+	h.pictures.Delete(sessionID)
+	h.autoRead.Delete(sessionID)
+	h.autoReject.Delete(sessionID)
+	h.rejections.Delete(sessionID)
+	h.readiness.Reset(sessionID)
+	h.handlersMu.Lock()
+	delete(h.handlerIDs, sessionID)
+	h.handlersMu.Unlock()
+	h.history.Clear(sessionID)
+	h.calls.Clear(sessionID)
+}
+
+// PendingCall retorna o autor de uma oferta de chamada recente ainda não encerrada
+func (h *Handler) PendingCall(sessionID, callID string) (types.JID, bool) {
+	return h.calls.Lookup(sessionID, callID)
+}
+
+// ForgetCall remove uma chamada do rastreamento (ex: após rejeição)
+func (h *Handler) ForgetCall(sessionID, callID string) {
+	h.calls.Forget(sessionID, callID)
+}
+
+// RecentEvents retorna os eventos recentes de uma sessão posteriores a since
+func (h *Handler) RecentEvents(sessionID string, since time.Time) []services.RecordedEvent {
+	return h.history.Recent(sessionID, since)
+}
+
+// getClient retorna o cliente registrado para uma sessão
+func (h *Handler) getClient(sessionID string) ClientInterface {
+	if value, ok := h.clients.Load(sessionID); ok {
+		return value.(ClientInterface)
+	}
+	return nil
 }
 
 // handleEvent processa eventos de forma otimizada
@@ -44,30 +161,17 @@ func (h *Handler) handleEvent(sessionID string, evt interface{}) {
 	// Log estruturado do evento
 	h.logger.LogEvent(sessionID, evt)
 
-	// Dispatch por tipo para handlers específicos
-	switch e := evt.(type) {
-	case *events.Connected:
-		h.handleConnected(sessionID, e)
-	case *events.Disconnected:
-		h.handleDisconnected(sessionID, e)
-	case *events.QR:
-		h.handleQR(sessionID, e)
-	case *events.PairSuccess:
-		h.handlePairSuccess(sessionID, e)
-	case *events.LoggedOut:
-		h.handleLoggedOut(sessionID, e)
-	case *events.Message:
-		h.handleMessage(sessionID, e)
-	case *events.Receipt:
-		h.handleReceipt(sessionID, e)
-	case *events.Presence:
-		h.handlePresence(sessionID, e)
-	case *events.PushName:
-		h.handlePushName(sessionID, e)
-	default:
-		// Eventos não tratados especificamente
-		h.dispatcher.Dispatch(sessionID, "unknown", evt)
+	// Guardar no histórico recente para replay
+	h.history.Record(sessionID, evt)
+
+	// Dispatch por tipo para handlers específicos (tabela de rotas em registry.go)
+	if route, ok := routesByType[reflect.TypeOf(evt)]; ok {
+		route.handle(h, sessionID, evt)
+		return
 	}
+
+	// Eventos não tratados especificamente
+	h.dispatcher.Dispatch(sessionID, EventUnknown, evt)
 }
 
 // handleConnected processa evento de conexão
@@ -77,27 +181,32 @@ func (h *Handler) handleConnected(sessionID string, evt *events.Connected) {
 	// Atualizar status no banco
 	h.updateSessionStatus(sessionID, entities.StatusConnected)
 
+	// Aguardar a sincronização inicial antes de marcar como pronta
+	h.startWarmup(sessionID)
+
 	// Dispatch para subscribers
-	h.dispatcher.Dispatch(sessionID, "connected", evt)
+	h.dispatcher.Dispatch(sessionID, EventConnected, evt)
 }
 
 // handleDisconnected processa evento de desconexão
 func (h *Handler) handleDisconnected(sessionID string, evt *events.Disconnected) {
 	logger.Warn().Str("sessionID", sessionID).Msg("❌ Session disconnected")
 
+	h.readiness.Reset(sessionID)
+
 	// Atualizar status no banco
 	h.updateSessionStatus(sessionID, entities.StatusDisconnected)
 
 	// Dispatch para subscribers
-	h.dispatcher.Dispatch(sessionID, "disconnected", evt)
+	h.dispatcher.Dispatch(sessionID, EventDisconnected, evt)
 }
 
 // handleQR processa evento de QR code
 func (h *Handler) handleQR(sessionID string, evt *events.QR) {
-	logger.Info().Str("sessionID", sessionID).Msg("📱 QR event")
+	logger.Debug().Str("sessionID", sessionID).Msg("📱 QR event")
 
 	// Dispatch para subscribers
-	h.dispatcher.Dispatch(sessionID, "qr", evt)
+	h.dispatcher.Dispatch(sessionID, EventQR, evt)
 }
 
 // handlePairSuccess processa sucesso de pareamento
@@ -108,18 +217,82 @@ func (h *Handler) handlePairSuccess(sessionID string, evt *events.PairSuccess) {
 	h.updateSessionJID(sessionID, evt.ID.String())
 
 	// Dispatch para subscribers
-	h.dispatcher.Dispatch(sessionID, "pair_success", evt)
+	h.dispatcher.Dispatch(sessionID, EventPairSuccess, evt)
+}
+
+// ErrPairRejected indica que a conta pareada não corresponde ao número reivindicado da sessão
+var ErrPairRejected = errors.New("paired account does not match the claimed phone")
+
+// PairRejected é despachado quando o pareamento é recusado por não corresponder ao número reivindicado
+type PairRejected struct {
+	ClaimedPhone string `json:"claimedPhone,omitempty"`
+	PairedJID    string `json:"pairedJID"`
+	Reason       string `json:"reason"`
+}
+
+// PairGuard retorna o callback que valida o JID pareado contra o número reivindicado da sessão.
+// Ao recusar, o whatsmeow desconecta o cliente e o erro fica disponível via PairRejection.
+func (h *Handler) PairGuard(sessionID string) func(jid types.JID, platform, businessName string) bool {
+	return func(jid types.JID, platform, businessName string) bool {
+		session, err := h.sessionRepo.GetByID(context.Background(), sessionID)
+		if err != nil {
+			// Sem a sessão não há como verificar o número reivindicado: recusar o pareamento
+			logger.Error().Str("sessionID", sessionID).Err(err).Msg("🚫 Failed to get session for pair verification, rejecting pairing")
+			h.rejectPairing(sessionID, "", jid, "claimed phone lookup failed",
+				fmt.Errorf("%w: could not verify the claimed phone: %v", ErrPairRejected, err))
+			return false
+		}
+		if session == nil || session.MatchesClaimedPhone(jid.User) {
+			return true
+		}
+
+		logger.Error().
+			Str("sessionID", sessionID).
+			Str("claimedPhone", session.ClaimedPhone).
+			Str("pairedJID", jid.String()).
+			Msg("🚫 Paired account does not match claimed phone, rejecting pairing")
+
+		h.rejectPairing(sessionID, session.ClaimedPhone, jid, "claimed phone mismatch",
+			fmt.Errorf("%w: claimed %s, paired %s", ErrPairRejected, session.ClaimedPhone, jid.User))
+		return false
+	}
+}
+
+// rejectPairing guarda o erro do pareamento recusado, marca a sessão como desconectada e notifica subscribers
+func (h *Handler) rejectPairing(sessionID, claimedPhone string, jid types.JID, reason string, err error) {
+	h.rejections.Store(sessionID, err)
+	h.updateSessionStatus(sessionID, entities.StatusDisconnected)
+	h.dispatcher.Dispatch(sessionID, EventPairRejected, &PairRejected{
+		ClaimedPhone: claimedPhone,
+		PairedJID:    jid.ToNonAD().String(),
+		Reason:       reason,
+	})
+}
+
+// PairRejection retorna o erro do último pareamento recusado da sessão, se houver
+func (h *Handler) PairRejection(sessionID string) error {
+	if value, ok := h.rejections.Load(sessionID); ok {
+		return value.(error)
+	}
+	return nil
+}
+
+// ClearPairRejection esquece o pareamento recusado ao iniciar uma nova tentativa
+func (h *Handler) ClearPairRejection(sessionID string) {
+	h.rejections.Delete(sessionID)
 }
 
 // handleLoggedOut processa logout
 func (h *Handler) handleLoggedOut(sessionID string, evt *events.LoggedOut) {
 	logger.Info().Str("sessionID", sessionID).Msg("🚪 Session logged out")
 
+	h.readiness.Reset(sessionID)
+
 	// Atualizar status no banco
 	h.updateSessionStatus(sessionID, entities.StatusDisconnected)
 
 	// Dispatch para subscribers
-	h.dispatcher.Dispatch(sessionID, "logged_out", evt)
+	h.dispatcher.Dispatch(sessionID, EventLoggedOut, evt)
 }
 
 // handleMessage processa mensagens
@@ -131,8 +304,13 @@ func (h *Handler) handleMessage(sessionID string, evt *events.Message) {
 		Bool("fromMe", evt.Info.IsFromMe).
 		Msg("📨 Message received")
 
+	// Confirmação de leitura automática (fora da goroutine de eventos do cliente)
+	if enabled, cached := h.cachedAutoMarkRead(sessionID); (enabled || !cached) && isAutoReadCandidate(evt) {
+		go h.autoMarkRead(sessionID, evt)
+	}
+
 	// Dispatch para subscribers
-	h.dispatcher.Dispatch(sessionID, "message", evt)
+	h.dispatcher.Dispatch(sessionID, EventMessage, evt)
 }
 
 // handleReceipt processa confirmações de leitura
@@ -143,7 +321,7 @@ func (h *Handler) handleReceipt(sessionID string, evt *events.Receipt) {
 		Msg("✅ Receipt received")
 
 	// Dispatch para subscribers
-	h.dispatcher.Dispatch(sessionID, "receipt", evt)
+	h.dispatcher.Dispatch(sessionID, EventReceipt, evt)
 }
 
 // handlePresence processa eventos de presença
@@ -154,7 +332,7 @@ func (h *Handler) handlePresence(sessionID string, evt *events.Presence) {
 		Msg("👁️ Presence update")
 
 	// Dispatch para subscribers
-	h.dispatcher.Dispatch(sessionID, "presence", evt)
+	h.dispatcher.Dispatch(sessionID, EventPresence, evt)
 }
 
 // handlePushName processa nomes de contatos
@@ -165,7 +343,40 @@ func (h *Handler) handlePushName(sessionID string, evt *events.PushName) {
 		Msg("👤 Push name update")
 
 	// Dispatch para subscribers
-	h.dispatcher.Dispatch(sessionID, "push_name", evt)
+	h.dispatcher.Dispatch(sessionID, EventPushName, evt)
+}
+
+// handleCallOffer processa ofertas de chamada recebidas
+func (h *Handler) handleCallOffer(sessionID string, evt *events.CallOffer) {
+	logger.Info().
+		Str("sessionID", sessionID).
+		Str("from", evt.From.String()).
+		Str("callID", evt.CallID).
+		Msg("📞 Call offer received")
+
+	// Rastrear para permitir rejeição via API
+	h.calls.Track(sessionID, evt.CallID, evt.From)
+
+	// Dispatch para subscribers
+	h.dispatcher.Dispatch(sessionID, EventCallOffer, evt)
+
+	if settings, cached := h.cachedAutoReject(sessionID); settings.enabled || !cached {
+		go h.autoRejectCall(sessionID, evt)
+	}
+}
+
+// handleCallTerminate processa encerramento de chamadas
+func (h *Handler) handleCallTerminate(sessionID string, evt *events.CallTerminate) {
+	logger.Debug().
+		Str("sessionID", sessionID).
+		Str("callID", evt.CallID).
+		Str("reason", evt.Reason).
+		Msg("📴 Call terminated")
+
+	h.calls.Forget(sessionID, evt.CallID)
+
+	// Dispatch para subscribers
+	h.dispatcher.Dispatch(sessionID, EventCallTerminate, evt)
 }
 
 // updateSessionStatus atualiza status da sessão no banco
@@ -207,5 +418,12 @@ type WrapperInterface interface {
 
 // ClientInterface define interface mínima para cliente
 type ClientInterface interface {
-	AddEventHandler(handler func(interface{}))
+	AddEventHandler(handler func(interface{})) uint32
+	RemoveEventHandler(id uint32) bool
+	MarkRead(ids []types.MessageID, timestamp time.Time, chat, sender types.JID, receiptTypeExtra ...types.ReceiptType) error
+	GetPrivacySettings(ctx context.Context) types.PrivacySettings
+	RejectCall(callFrom types.JID, callID string) error
+	SendText(ctx context.Context, to types.JID, text string) error
+	OwnJID() types.JID
+	OwnLID() types.JID
 }
@@ -0,0 +1,92 @@
+package events
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestHandlerRegistration(t *testing.T) {
+	tests := []struct {
+		name      string
+		steps     func(h *Handler, client *fakeClient)
+		wantCount int
+		wantIDs   int
+	}{
+		{
+			name:      "after setup",
+			steps:     func(h *Handler, client *fakeClient) {},
+			wantCount: 1,
+			wantIDs:   1,
+		},
+		{
+			name: "setup twice on the same client",
+			steps: func(h *Handler, client *fakeClient) {
+				h.Setup(&fakeWrapper{sessionID: "s1", client: client})
+			},
+			wantCount: 1,
+			wantIDs:   1,
+		},
+		{
+			name: "after reset",
+			steps: func(h *Handler, client *fakeClient) {
+				h.ResetHandlers("s1")
+				h.ResetHandlers("s1")
+			},
+			wantCount: 1,
+			wantIDs:   1,
+		},
+		{
+			name: "reset keeps whatsmeow handlers",
+			steps: func(h *Handler, client *fakeClient) {
+				// Simula o handler interno do canal de QR
+				client.AddEventHandler(func(interface{}) {})
+				h.ResetHandlers("s1")
+			},
+			wantCount: 2,
+			wantIDs:   1,
+		},
+		{
+			name: "after remove",
+			steps: func(h *Handler, client *fakeClient) {
+				h.Remove("s1")
+			},
+			wantCount: 1,
+			wantIDs:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, client := newTestHandler(newFakeSessionRepo(), time.Hour, "s1")
+			tt.steps(h, client)
+
+			if count := client.handlerCount(); count != tt.wantCount {
+				t.Fatalf("client handlers = %d, want %d", count, tt.wantCount)
+			}
+			if ids := h.HandlerIDs("s1"); len(ids) != tt.wantIDs {
+				t.Fatalf("HandlerIDs() = %v, want %d IDs", ids, tt.wantIDs)
+			}
+		})
+	}
+}
+
+func TestResetHandlers(t *testing.T) {
+	h, client := newTestHandler(newFakeSessionRepo(), time.Hour, "s1")
+	before := h.HandlerIDs("s1")
+
+	ids, ok := h.ResetHandlers("s1")
+	if !ok {
+		t.Fatalf("ResetHandlers() found no client")
+	}
+	if slices.Equal(ids, before) || !slices.Equal(ids, h.HandlerIDs("s1")) {
+		t.Fatalf("ResetHandlers() = %v, want new IDs replacing %v", ids, before)
+	}
+	if client.handlerCount() != 1 {
+		t.Fatalf("client handlers = %d, want 1", client.handlerCount())
+	}
+
+	if _, ok := h.ResetHandlers("unknown"); ok {
+		t.Fatalf("ResetHandlers() succeeded for an unknown session")
+	}
+}
@@ -0,0 +1,137 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mau.fi/whatsmeow/types/events"
+
+	"wazmeow/internal/domain/services"
+	"wazmeow/pkg/logger"
+)
+
+// Motivos pelos quais o payload de um evento não é guardado
+const (
+	omittedSensitive = "sensitive"
+	omittedTooLarge  = "too_large"
+)
+
+// HistoryLimits limita o histórico de eventos por sessão
+type HistoryLimits struct {
+	Events     int // número máximo de eventos
+	Bytes      int // soma máxima dos payloads
+	EventBytes int // tamanho máximo do payload de um evento
+}
+
+// History mantém os últimos eventos serializados por sessão, limitados em quantidade e bytes
+type History struct {
+	limits   HistoryLimits
+	buffers  map[string]*eventQueue
+	sequence uint64
+	mu       sync.Mutex
+}
+
+// eventQueue é a fila de eventos de uma sessão, do mais antigo ao mais recente
+type eventQueue struct {
+	events []services.RecordedEvent
+	bytes  int
+}
+
+// NewHistory cria um novo histórico com os limites por sessão
+func NewHistory(limits HistoryLimits) *History {
+	return &History{
+		limits:  limits,
+		buffers: make(map[string]*eventQueue),
+	}
+}
+
+// Record serializa e armazena um evento, descartando os mais antigos além dos limites
+func (h *History) Record(sessionID string, evt interface{}) {
+	if h.limits.Events <= 0 {
+		return
+	}
+
+	recorded := services.RecordedEvent{
+		Type:      eventTypeName(evt),
+		Timestamp: time.Now(),
+	}
+
+	switch evt.(type) {
+	case *events.QR:
+		// Códigos de QR permitiriam parear a sessão, nunca são guardados
+		return
+	case *events.PairSuccess, *events.PairError, *events.HistorySync:
+		// Pareamento é sensível e HistorySync é grande demais para serializar aqui
+		recorded.Omitted = omittedSensitive
+	default:
+		payload, err := json.Marshal(evt)
+		if err != nil {
+			logger.Warn().Str("sessionID", sessionID).Str("type", recorded.Type).Err(err).Msg("Failed to serialize event for history")
+			return
+		}
+		if h.limits.EventBytes > 0 && len(payload) > h.limits.EventBytes {
+			recorded.Omitted = omittedTooLarge
+		} else {
+			recorded.Payload = payload
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	queue, ok := h.buffers[sessionID]
+	if !ok {
+		queue = &eventQueue{}
+		h.buffers[sessionID] = queue
+	}
+
+	h.sequence++
+	recorded.Sequence = h.sequence
+	queue.events = append(queue.events, recorded)
+	queue.bytes += len(recorded.Payload)
+
+	for len(queue.events) > h.limits.Events || (h.limits.Bytes > 0 && queue.bytes > h.limits.Bytes) {
+		queue.bytes -= len(queue.events[0].Payload)
+		queue.events[0] = services.RecordedEvent{}
+		queue.events = queue.events[1:]
+	}
+}
+
+// Recent retorna os eventos de uma sessão posteriores a since, do mais antigo ao mais recente
+func (h *History) Recent(sessionID string, since time.Time) []services.RecordedEvent {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	queue, ok := h.buffers[sessionID]
+	if !ok {
+		return []services.RecordedEvent{}
+	}
+
+	result := make([]services.RecordedEvent, 0, len(queue.events))
+	for _, evt := range queue.events {
+		if evt.Timestamp.After(since) {
+			result = append(result, evt)
+		}
+	}
+
+	return result
+}
+
+// Clear remove o histórico de uma sessão
+func (h *History) Clear(sessionID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.buffers, sessionID)
+}
+
+// eventTypeName retorna o nome do tipo do evento whatsmeow (ex: "Message")
+func eventTypeName(evt interface{}) string {
+	name := fmt.Sprintf("%T", evt)
+	if idx := strings.LastIndex(name, "."); idx >= 0 {
+		name = name[idx+1:]
+	}
+	return name
+}
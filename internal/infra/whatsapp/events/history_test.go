@@ -0,0 +1,124 @@
+package events
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestHistoryRecord(t *testing.T) {
+	pushName := &events.PushName{JID: types.NewJID("5511999999999", types.DefaultUserServer), NewPushName: "Ana"}
+	payload, err := json.Marshal(pushName)
+	if err != nil {
+		t.Fatalf("marshal event: %v", err)
+	}
+	size := len(payload)
+
+	tests := []struct {
+		name        string
+		limits      HistoryLimits
+		record      []interface{}
+		wantSeq     []uint64
+		wantOmitted []string
+	}{
+		{
+			name:    "within window",
+			limits:  HistoryLimits{Events: 5},
+			record:  []interface{}{pushName, pushName, pushName},
+			wantSeq: []uint64{1, 2, 3},
+		},
+		{
+			name:    "evicted beyond N",
+			limits:  HistoryLimits{Events: 3},
+			record:  []interface{}{pushName, pushName, pushName, pushName, pushName},
+			wantSeq: []uint64{3, 4, 5},
+		},
+		{
+			name:    "evicted beyond byte budget",
+			limits:  HistoryLimits{Events: 10, Bytes: 2 * size},
+			record:  []interface{}{pushName, pushName, pushName, pushName},
+			wantSeq: []uint64{3, 4},
+		},
+		{
+			name:        "large payload omitted",
+			limits:      HistoryLimits{Events: 5, EventBytes: size - 1},
+			record:      []interface{}{pushName},
+			wantSeq:     []uint64{1},
+			wantOmitted: []string{omittedTooLarge},
+		},
+		{
+			name:        "pairing omitted and QR dropped",
+			limits:      HistoryLimits{Events: 5},
+			record:      []interface{}{&events.QR{Codes: []string{"code"}}, &events.PairSuccess{}},
+			wantSeq:     []uint64{1},
+			wantOmitted: []string{omittedSensitive},
+		},
+		{
+			name:   "disabled",
+			limits: HistoryLimits{},
+			record: []interface{}{pushName},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history := NewHistory(tt.limits)
+			start := time.Now().Add(-time.Second)
+			for _, evt := range tt.record {
+				history.Record("s1", evt)
+			}
+
+			recent := history.Recent("s1", start)
+			if len(recent) != len(tt.wantSeq) {
+				t.Fatalf("got %d events, want %d", len(recent), len(tt.wantSeq))
+			}
+			for i, evt := range recent {
+				if evt.Sequence != tt.wantSeq[i] {
+					t.Fatalf("event %d sequence = %d, want %d", i, evt.Sequence, tt.wantSeq[i])
+				}
+				wantOmitted := ""
+				if tt.wantOmitted != nil {
+					wantOmitted = tt.wantOmitted[i]
+				}
+				if evt.Omitted != wantOmitted {
+					t.Fatalf("event %d omitted = %q, want %q", i, evt.Omitted, wantOmitted)
+				}
+				if wantOmitted == "" && string(evt.Payload) != string(payload) {
+					t.Fatalf("event %d payload = %s, want %s", i, evt.Payload, payload)
+				}
+				if wantOmitted != "" && evt.Payload != nil {
+					t.Fatalf("event %d kept payload %s", i, evt.Payload)
+				}
+			}
+		})
+	}
+}
+
+func TestHistoryRecentSince(t *testing.T) {
+	history := NewHistory(HistoryLimits{Events: 10})
+	history.Record("s1", &events.Connected{})
+	history.Record("s2", &events.Connected{})
+
+	// O since é exclusivo: apenas eventos posteriores ao último visto são reenviados
+	first := history.Recent("s1", time.Time{})
+	if len(first) != 1 || first[0].Type != "Connected" {
+		t.Fatalf("Recent() = %+v, want the Connected event", first)
+	}
+	time.Sleep(time.Millisecond)
+	history.Record("s1", &events.Disconnected{})
+
+	if recent := history.Recent("s1", first[0].Timestamp); len(recent) != 1 || recent[0].Type != "Disconnected" {
+		t.Fatalf("Recent(since) = %+v, want only the Disconnected event", recent)
+	}
+
+	history.Clear("s1")
+	if recent := history.Recent("s1", time.Time{}); len(recent) != 0 {
+		t.Fatalf("Recent() after Clear = %+v, want empty", recent)
+	}
+	if recent := history.Recent("s2", time.Time{}); len(recent) != 1 {
+		t.Fatalf("Clear removed other sessions: %+v", recent)
+	}
+}
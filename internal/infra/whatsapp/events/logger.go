@@ -83,7 +83,7 @@ func (l *Logger) logDisconnected(sessionID string, evt *events.Disconnected) {
 
 // logQR faz log de eventos QR
 func (l *Logger) logQR(sessionID string, evt *events.QR) {
-	logger.Info().
+	logger.Debug().
 		Str("sessionID", sessionID).
 		Msg("📱 QR")
 }
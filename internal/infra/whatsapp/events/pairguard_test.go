@@ -0,0 +1,73 @@
+package events
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+
+	"wazmeow/internal/domain/entities"
+)
+
+func TestPairGuard(t *testing.T) {
+	paired := types.NewJID("5511888888888", types.DefaultUserServer)
+
+	tests := []struct {
+		name         string
+		claimedPhone string
+		repoErr      error
+		accept       bool
+	}{
+		{name: "no claimed phone", claimedPhone: "", accept: true},
+		{name: "claimed phone matches", claimedPhone: "5511888888888", accept: true},
+		{name: "claimed phone mismatch", claimedPhone: "5511777777777", accept: false},
+		{name: "database error fails closed", claimedPhone: "5511888888888", repoErr: errDB, accept: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := entities.NewSession("guarded")
+			session.ID = "s1"
+			session.ClaimedPhone = tt.claimedPhone
+			session.Status = entities.StatusConnecting
+			repo := newFakeSessionRepo(session)
+			repo.err = tt.repoErr
+
+			h, _ := newTestHandler(repo, time.Hour, "s1")
+			recorder := subscribeAll(h)
+
+			if got := h.PairGuard("s1")(paired, "android", ""); got != tt.accept {
+				t.Fatalf("PairGuard() = %v, want %v", got, tt.accept)
+			}
+
+			rejection := h.PairRejection("s1")
+			if tt.accept {
+				if rejection != nil {
+					t.Fatalf("PairRejection() = %v, want nil", rejection)
+				}
+				return
+			}
+
+			if !errors.Is(rejection, ErrPairRejected) {
+				t.Fatalf("PairRejection() = %v, want ErrPairRejected", rejection)
+			}
+			if status := repo.status("s1"); status != entities.StatusDisconnected {
+				t.Fatalf("session status = %q, want %q", status, entities.StatusDisconnected)
+			}
+			evt, ok := recorder.waitFor(EventPairRejected)
+			if !ok {
+				t.Fatalf("pair_rejected event not dispatched")
+			}
+			if rejected := evt.data.(*PairRejected); rejected.PairedJID != paired.String() {
+				t.Fatalf("PairedJID = %q, want %q", rejected.PairedJID, paired.String())
+			}
+
+			// Uma nova tentativa de conexão esquece a recusa anterior
+			h.ClearPairRejection("s1")
+			if rejection := h.PairRejection("s1"); rejection != nil {
+				t.Fatalf("PairRejection() after clear = %v, want nil", rejection)
+			}
+		})
+	}
+}
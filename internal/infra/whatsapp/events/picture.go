@@ -0,0 +1,70 @@
+package events
+
+import (
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"wazmeow/pkg/logger"
+)
+
+// ProfilePictureChanged é despachado quando a foto de perfil da própria conta muda
+type ProfilePictureChanged struct {
+	JID       string    `json:"jid"`
+	PictureID string    `json:"pictureID,omitempty"`
+	Removed   bool      `json:"removed"`
+	Author    string    `json:"author,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// handlePicture processa mudanças de foto de perfil, normalizando as da própria conta
+func (h *Handler) handlePicture(sessionID string, evt *events.Picture) {
+	client := h.getClient(sessionID)
+	if client == nil || !isOwnAccount(client, evt.JID) {
+		h.dispatcher.Dispatch(sessionID, EventPicture, evt)
+		return
+	}
+
+	h.SetProfilePictureID(sessionID, evt.PictureID)
+
+	logger.Info().
+		Str("sessionID", sessionID).
+		Str("pictureID", evt.PictureID).
+		Bool("removed", evt.Remove).
+		Msg("🖼️ Own profile picture changed")
+
+	changed := &ProfilePictureChanged{
+		JID:       evt.JID.ToNonAD().String(),
+		PictureID: evt.PictureID,
+		Removed:   evt.Remove,
+		Timestamp: evt.Timestamp,
+	}
+	if !evt.Author.IsEmpty() {
+		changed.Author = evt.Author.ToNonAD().String()
+	}
+
+	h.dispatcher.Dispatch(sessionID, EventProfilePictureChanged, changed)
+}
+
+// isOwnAccount verifica se o JID é da própria conta, pelo número de telefone ou pelo LID
+func isOwnAccount(client ClientInterface, jid types.JID) bool {
+	jid = jid.ToNonAD()
+	if jid.IsEmpty() {
+		return false
+	}
+	return jid == client.OwnJID().ToNonAD() || jid == client.OwnLID().ToNonAD()
+}
+
+// ProfilePictureID retorna o ID em cache da foto de perfil da própria conta
+func (h *Handler) ProfilePictureID(sessionID string) (string, bool) {
+	if value, ok := h.pictures.Load(sessionID); ok {
+		return value.(string), true
+	}
+	return "", false
+}
+
+// SetProfilePictureID atualiza o ID em cache da foto de perfil da própria conta (vazio quando removida)
+func (h *Handler) SetProfilePictureID(sessionID, pictureID string) {
+	h.pictures.Store(sessionID, pictureID)
+}
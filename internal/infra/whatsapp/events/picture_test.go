@@ -0,0 +1,66 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestHandlePicture(t *testing.T) {
+	client := newFakeClient()
+	ownDevice := types.NewADJID(client.ownJID.User, 0, 2)
+	ownLID := client.ownLID
+	author := types.NewADJID("5511777777777", 0, 1)
+
+	tests := []struct {
+		name       string
+		evt        *events.Picture
+		wantOwn    bool
+		wantCached string
+	}{
+		{name: "own phone JID", evt: &events.Picture{JID: client.ownJID, PictureID: "p1"}, wantOwn: true, wantCached: "p1"},
+		{name: "own device JID", evt: &events.Picture{JID: ownDevice, PictureID: "p2", Author: author}, wantOwn: true, wantCached: "p2"},
+		{name: "own LID", evt: &events.Picture{JID: ownLID, PictureID: "p3"}, wantOwn: true, wantCached: "p3"},
+		{name: "own picture removed", evt: &events.Picture{JID: client.ownJID, Remove: true}, wantOwn: true, wantCached: ""},
+		{name: "contact", evt: &events.Picture{JID: types.NewJID("5511888888888", types.DefaultUserServer), PictureID: "p4"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, client := newTestHandler(newFakeSessionRepo(), time.Hour, "s1")
+			defer h.Remove("s1")
+			recorder := subscribeAll(h)
+			tt.evt.Timestamp = time.Now()
+
+			deliver(client, tt.evt)
+
+			cached, ok := h.ProfilePictureID("s1")
+			if !tt.wantOwn {
+				if ok {
+					t.Fatalf("ProfilePictureID() cached %q for a contact", cached)
+				}
+				if _, dispatched := recorder.waitFor(EventPicture); !dispatched {
+					t.Fatalf("raw picture event not dispatched")
+				}
+				return
+			}
+
+			if !ok || cached != tt.wantCached {
+				t.Fatalf("ProfilePictureID() = (%q, %v), want %q", cached, ok, tt.wantCached)
+			}
+			evt, dispatched := recorder.waitFor(EventProfilePictureChanged)
+			if !dispatched {
+				t.Fatalf("profile picture changed event not dispatched")
+			}
+			changed := evt.data.(*ProfilePictureChanged)
+			if changed.JID != tt.evt.JID.ToNonAD().String() || changed.PictureID != tt.evt.PictureID || changed.Removed != tt.evt.Remove {
+				t.Fatalf("changed = %+v, want event %+v", changed, tt.evt)
+			}
+			if !tt.evt.Author.IsEmpty() && changed.Author != author.ToNonAD().String() {
+				t.Fatalf("Author = %q, want %q", changed.Author, author.ToNonAD().String())
+			}
+		})
+	}
+}
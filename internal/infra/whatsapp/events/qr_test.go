@@ -0,0 +1,27 @@
+package events
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestHandleQRDispatches(t *testing.T) {
+	// O webhook de QR não depende da exibição no terminal, controlada apenas pelo processor de QR
+	h, client := newTestHandler(newFakeSessionRepo(), time.Hour, "s1")
+	defer h.Remove("s1")
+	recorder := subscribeAll(h)
+
+	qr := &events.QR{Codes: []string{"2@abc", "2@def"}}
+	deliver(client, qr)
+
+	dispatched, ok := recorder.waitFor(EventQR)
+	if !ok {
+		t.Fatalf("qr event not dispatched, got %v", recorder.eventTypes())
+	}
+	if got, ok := dispatched.data.(*events.QR); !ok || !slices.Equal(got.Codes, qr.Codes) || dispatched.sessionID != "s1" {
+		t.Fatalf("dispatched %+v, want the QR codes for s1", dispatched)
+	}
+}
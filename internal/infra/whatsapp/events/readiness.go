@@ -0,0 +1,149 @@
+package events
+
+import (
+	"sync"
+	"time"
+
+	"go.mau.fi/whatsmeow/appstate"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"wazmeow/pkg/logger"
+)
+
+// criticalPatches são os app states necessários para envios (push name, configurações e contatos)
+var criticalPatches = []appstate.WAPatchName{appstate.WAPatchCriticalBlock, appstate.WAPatchCriticalUnblockLow}
+
+// Readiness controla quando uma sessão conectada está pronta para envios.
+// Após conectar, a sessão só fica pronta quando todos os app states críticos sincronizam
+// ou o warmup expira.
+type Readiness struct {
+	timeout time.Duration
+	ready   map[string]bool
+	synced  map[string]map[appstate.WAPatchName]bool
+	timers  map[string]*time.Timer
+	mu      sync.Mutex
+}
+
+// NewReadiness cria um controle de prontidão com o timeout de warmup informado (0 desativa o warmup)
+func NewReadiness(timeout time.Duration) *Readiness {
+	return &Readiness{
+		timeout: timeout,
+		ready:   make(map[string]bool),
+		synced:  make(map[string]map[appstate.WAPatchName]bool),
+		timers:  make(map[string]*time.Timer),
+	}
+}
+
+// Start inicia o warmup de uma sessão recém-conectada; onTimeout é chamado se a sincronização não terminar a tempo
+func (r *Readiness) Start(sessionID string, onTimeout func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.stopTimer(sessionID)
+	if r.timeout <= 0 {
+		r.ready[sessionID] = true
+		return
+	}
+
+	r.ready[sessionID] = false
+	r.synced[sessionID] = make(map[appstate.WAPatchName]bool)
+	r.timers[sessionID] = time.AfterFunc(r.timeout, func() {
+		if r.MarkReady(sessionID) {
+			onTimeout()
+		}
+	})
+}
+
+// MarkReady marca a sessão como pronta, retornando true se ela ainda não estava
+func (r *Readiness) MarkReady(sessionID string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if ready, ok := r.ready[sessionID]; !ok || ready {
+		return false
+	}
+
+	r.stopTimer(sessionID)
+	r.ready[sessionID] = true
+	return true
+}
+
+// MarkSynced registra a sincronização de um app state, retornando true quando todos os
+// críticos já sincronizaram
+func (r *Readiness) MarkSynced(sessionID string, name appstate.WAPatchName) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	synced, ok := r.synced[sessionID]
+	if !ok {
+		return false
+	}
+	synced[name] = true
+
+	for _, patch := range criticalPatches {
+		if !synced[patch] {
+			return false
+		}
+	}
+	return true
+}
+
+// Reset marca a sessão como não pronta (ex: após desconexão)
+func (r *Readiness) Reset(sessionID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.stopTimer(sessionID)
+	delete(r.ready, sessionID)
+	delete(r.synced, sessionID)
+}
+
+// IsReady verifica se a sessão está pronta para envios
+func (r *Readiness) IsReady(sessionID string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.ready[sessionID]
+}
+
+// stopTimer cancela o timer de warmup pendente; deve ser chamado com o lock adquirido
+func (r *Readiness) stopTimer(sessionID string) {
+	if timer, ok := r.timers[sessionID]; ok {
+		timer.Stop()
+		delete(r.timers, sessionID)
+	}
+}
+
+// handleAppStateSyncComplete processa o fim da sincronização de um app state; o evento é
+// emitido uma vez por app state, então só os críticos sincronizados liberam a sessão
+func (h *Handler) handleAppStateSyncComplete(sessionID string, evt *events.AppStateSyncComplete) {
+	if h.readiness.MarkSynced(sessionID, evt.Name) {
+		h.handleSyncComplete(sessionID, evt)
+	}
+}
+
+// handleOfflineSyncCompleted registra o fim da sincronização de mensagens offline; sem os
+// app states críticos a sessão ainda não está pronta
+func (h *Handler) handleOfflineSyncCompleted(sessionID string, evt *events.OfflineSyncCompleted) {
+	logger.Debug().Str("sessionID", sessionID).Int("count", evt.Count).Msg("Offline sync completed")
+}
+
+// handleSyncComplete marca a sessão como pronta ao concluir a sincronização inicial
+func (h *Handler) handleSyncComplete(sessionID string, evt interface{}) {
+	if h.readiness.MarkReady(sessionID) {
+		logger.Info().Str("sessionID", sessionID).Msg("🟢 Session ready")
+		h.dispatcher.Dispatch(sessionID, EventReady, evt)
+	}
+}
+
+// startWarmup inicia o warmup de uma sessão recém-conectada
+func (h *Handler) startWarmup(sessionID string) {
+	h.readiness.Start(sessionID, func() {
+		logger.Warn().Str("sessionID", sessionID).Msg("🟡 Warmup timed out, marking session ready")
+		h.dispatcher.Dispatch(sessionID, EventReady, nil)
+	})
+}
+
+// IsReady verifica se a sessão concluiu o warmup após conectar
+func (h *Handler) IsReady(sessionID string) bool {
+	return h.readiness.IsReady(sessionID)
+}
@@ -0,0 +1,134 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/appstate"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestReadinessAfterSync(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []interface{}
+		ready  bool
+	}{
+		{
+			name:   "connected only",
+			events: nil,
+			ready:  false,
+		},
+		{
+			name:   "offline sync does not mark ready",
+			events: []interface{}{&events.OfflineSyncCompleted{Count: 3}},
+			ready:  false,
+		},
+		{
+			name: "one critical patch is not enough",
+			events: []interface{}{
+				&events.OfflineSyncCompleted{},
+				&events.AppStateSyncComplete{Name: appstate.WAPatchCriticalBlock},
+			},
+			ready: false,
+		},
+		{
+			name: "non critical patches are ignored",
+			events: []interface{}{
+				&events.AppStateSyncComplete{Name: appstate.WAPatchRegular},
+				&events.AppStateSyncComplete{Name: appstate.WAPatchRegularHigh},
+			},
+			ready: false,
+		},
+		{
+			name: "all critical patches mark ready",
+			events: []interface{}{
+				&events.AppStateSyncComplete{Name: appstate.WAPatchCriticalUnblockLow},
+				&events.AppStateSyncComplete{Name: appstate.WAPatchCriticalBlock},
+			},
+			ready: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, client := newTestHandler(newFakeSessionRepo(), time.Hour, "s1")
+			defer h.Remove("s1")
+			recorder := subscribeAll(h)
+
+			deliver(client, &events.Connected{})
+			for _, evt := range tt.events {
+				deliver(client, evt)
+			}
+
+			if got := h.IsReady("s1"); got != tt.ready {
+				t.Fatalf("IsReady() = %v, want %v", got, tt.ready)
+			}
+			if tt.ready {
+				if _, dispatched := recorder.waitFor(EventReady); !dispatched {
+					t.Fatalf("ready event not dispatched")
+				}
+			}
+		})
+	}
+}
+
+func TestReadinessWarmupTimeout(t *testing.T) {
+	h, client := newTestHandler(newFakeSessionRepo(), 20*time.Millisecond, "s1")
+	defer h.Remove("s1")
+	recorder := subscribeAll(h)
+
+	deliver(client, &events.Connected{})
+	if h.IsReady("s1") {
+		t.Fatalf("session ready before warmup elapsed")
+	}
+
+	evt, ok := recorder.waitFor(EventReady)
+	if !ok {
+		t.Fatalf("ready event not dispatched after warmup timeout")
+	}
+	if evt.data != nil {
+		t.Fatalf("warmup ready event data = %v, want nil", evt.data)
+	}
+	if !h.IsReady("s1") {
+		t.Fatalf("session not ready after warmup timeout")
+	}
+}
+
+func TestReadinessResetOnDisconnect(t *testing.T) {
+	h, client := newTestHandler(newFakeSessionRepo(), time.Hour, "s1")
+	defer h.Remove("s1")
+
+	deliver(client, &events.Connected{})
+	deliver(client, &events.AppStateSyncComplete{Name: appstate.WAPatchCriticalBlock})
+	deliver(client, &events.AppStateSyncComplete{Name: appstate.WAPatchCriticalUnblockLow})
+	if !h.IsReady("s1") {
+		t.Fatalf("session not ready after critical sync")
+	}
+
+	deliver(client, &events.Disconnected{})
+	if h.IsReady("s1") {
+		t.Fatalf("session still ready after disconnect")
+	}
+
+	// Após reconectar, a sincronização anterior não conta
+	deliver(client, &events.Connected{})
+	deliver(client, &events.AppStateSyncComplete{Name: appstate.WAPatchCriticalBlock})
+	if h.IsReady("s1") {
+		t.Fatalf("session ready with a stale critical sync")
+	}
+}
+
+// deliver entrega um evento a todos os handlers registrados no cliente fake, como o whatsmeow faria
+func deliver(client *fakeClient, evt interface{}) {
+	client.mu.Lock()
+	handlers := make([]func(interface{}), 0, len(client.handlers))
+	for _, handler := range client.handlers {
+		handlers = append(handlers, handler)
+	}
+	client.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler(evt)
+	}
+}
@@ -0,0 +1,124 @@
+package events
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	"wazmeow/internal/domain/services"
+)
+
+// Nomes normalizados dos eventos despachados para subscribers
+const (
+	EventConnected             = "connected"
+	EventDisconnected          = "disconnected"
+	EventQR                    = "qr"
+	EventPairSuccess           = "pair_success"
+	EventPairRejected          = "pair_rejected"
+	EventLoggedOut             = "logged_out"
+	EventMessage               = "message"
+	EventReceipt               = "receipt"
+	EventPresence              = "presence"
+	EventPushName              = "push_name"
+	EventCallOffer             = "call_offer"
+	EventCallTerminate         = "call_terminate"
+	EventCallAutoRejected      = "call_auto_rejected"
+	EventPicture               = "picture"
+	EventProfilePictureChanged = "profile.picture_changed"
+	EventReady                 = "ready"
+	EventUnknown               = "unknown"
+)
+
+// emittedEvent descreve um evento normalizado despachado para subscribers
+type emittedEvent struct {
+	name        string
+	description string
+}
+
+// eventRoute liga um tipo de evento whatsmeow ao seu handler e aos eventos que ele despacha.
+// Rotas sem eventType documentam eventos despachados fora de handleEvent.
+type eventRoute struct {
+	eventType     reflect.Type
+	whatsmeowType string
+	handle        func(h *Handler, sessionID string, evt interface{})
+	emits         []emittedEvent
+}
+
+// on cria a rota de um tipo de evento whatsmeow a partir do seu handler tipado
+func on[T any](handle func(h *Handler, sessionID string, evt *T), emits ...emittedEvent) eventRoute {
+	eventType := reflect.TypeOf((*T)(nil))
+	return eventRoute{
+		eventType:     eventType,
+		whatsmeowType: eventType.Elem().Name(),
+		handle: func(h *Handler, sessionID string, evt interface{}) {
+			handle(h, sessionID, evt.(*T))
+		},
+		emits: emits,
+	}
+}
+
+// readyEvent é despachado tanto pela sincronização dos app states críticos quanto pelo timeout de warmup
+var readyEvent = emittedEvent{EventReady, "Session synced its critical app states or finished warmup and is ready to send"}
+
+// routes é a tabela única usada tanto por handleEvent quanto pelo registry
+var routes = []eventRoute{
+	on((*Handler).handleConnected, emittedEvent{EventConnected, "Client connected and authenticated"}),
+	on((*Handler).handleDisconnected, emittedEvent{EventDisconnected, "Client disconnected from the server"}),
+	on((*Handler).handleQR, emittedEvent{EventQR, "New QR codes available for pairing"}),
+	on((*Handler).handlePairSuccess, emittedEvent{EventPairSuccess, "Device paired successfully"}),
+	on((*Handler).handleLoggedOut, emittedEvent{EventLoggedOut, "Device logged out from the phone or server"}),
+	on((*Handler).handleMessage, emittedEvent{EventMessage, "Message received or sent from another device"}),
+	on((*Handler).handleReceipt, emittedEvent{EventReceipt, "Delivery, read or played receipt"}),
+	on((*Handler).handlePresence, emittedEvent{EventPresence, "Contact online status or last seen update"}),
+	on((*Handler).handlePushName, emittedEvent{EventPushName, "Contact push name changed"}),
+	on((*Handler).handleCallOffer,
+		emittedEvent{EventCallOffer, "Incoming call offered"},
+		emittedEvent{EventCallAutoRejected, "Incoming call rejected automatically by session settings"}),
+	on((*Handler).handleCallTerminate, emittedEvent{EventCallTerminate, "Call ended"}),
+	on((*Handler).handlePicture,
+		emittedEvent{EventPicture, "Contact or group picture changed"},
+		emittedEvent{EventProfilePictureChanged, "Own profile picture changed or removed"}),
+	on((*Handler).handleAppStateSyncComplete, readyEvent),
+	on((*Handler).handleOfflineSyncCompleted),
+	{whatsmeowType: "", emits: []emittedEvent{{EventPairRejected, "Pairing refused because the account does not match the claimed phone or it could not be verified"}}},
+	{whatsmeowType: "*", emits: []emittedEvent{{EventUnknown, "Any other whatsmeow event, forwarded without normalization"}}},
+}
+
+// routesByType indexa as rotas pelo tipo do evento whatsmeow
+var routesByType = func() map[reflect.Type]eventRoute {
+	byType := make(map[reflect.Type]eventRoute, len(routes))
+	for _, route := range routes {
+		if route.eventType != nil {
+			byType[route.eventType] = route
+		}
+	}
+	return byType
+}()
+
+// Registry retorna a lista de eventos tratados com seus nomes normalizados, um item por nome
+func Registry() []services.EventTypeInfo {
+	var result []services.EventTypeInfo
+	index := make(map[string]int)
+
+	for _, route := range routes {
+		for _, emitted := range route.emits {
+			if i, ok := index[emitted.name]; ok {
+				// Mesmo evento despachado a partir de outro tipo whatsmeow
+				if route.whatsmeowType != "" && !slices.Contains(strings.Split(result[i].WhatsmeowType, ","), route.whatsmeowType) {
+					result[i].WhatsmeowType += "," + route.whatsmeowType
+				}
+				continue
+			}
+
+			index[emitted.name] = len(result)
+			result = append(result, services.EventTypeInfo{
+				WhatsmeowType:      route.whatsmeowType,
+				Name:               emitted.name,
+				Description:        emitted.description,
+				ForwardedByDefault: emitted.name != EventUnknown,
+			})
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,102 @@
+package events
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestRegistry(t *testing.T) {
+	tests := []struct {
+		name          string
+		whatsmeowType string
+		forwarded     bool
+	}{
+		{name: EventConnected, whatsmeowType: "Connected", forwarded: true},
+		{name: EventDisconnected, whatsmeowType: "Disconnected", forwarded: true},
+		{name: EventQR, whatsmeowType: "QR", forwarded: true},
+		{name: EventPairSuccess, whatsmeowType: "PairSuccess", forwarded: true},
+		{name: EventPairRejected, forwarded: true},
+		{name: EventLoggedOut, whatsmeowType: "LoggedOut", forwarded: true},
+		{name: EventMessage, whatsmeowType: "Message", forwarded: true},
+		{name: EventReceipt, whatsmeowType: "Receipt", forwarded: true},
+		{name: EventPresence, whatsmeowType: "Presence", forwarded: true},
+		{name: EventPushName, whatsmeowType: "PushName", forwarded: true},
+		{name: EventCallOffer, whatsmeowType: "CallOffer", forwarded: true},
+		{name: EventCallAutoRejected, whatsmeowType: "CallOffer", forwarded: true},
+		{name: EventCallTerminate, whatsmeowType: "CallTerminate", forwarded: true},
+		{name: EventPicture, whatsmeowType: "Picture", forwarded: true},
+		{name: EventProfilePictureChanged, whatsmeowType: "Picture", forwarded: true},
+		{name: EventReady, whatsmeowType: "AppStateSyncComplete", forwarded: true},
+		{name: EventUnknown, whatsmeowType: "*", forwarded: false},
+	}
+
+	registry := Registry()
+	byName := make(map[string]int, len(registry))
+	for i, info := range registry {
+		if _, duplicate := byName[info.Name]; duplicate {
+			t.Fatalf("event %q listed twice", info.Name)
+		}
+		if info.Description == "" {
+			t.Fatalf("event %q has no description", info.Name)
+		}
+		byName[info.Name] = i
+	}
+	if len(registry) != len(tests) {
+		t.Fatalf("registry has %d events, want %d", len(registry), len(tests))
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i, ok := byName[tt.name]
+			if !ok {
+				t.Fatalf("event %q missing from the registry", tt.name)
+			}
+			info := registry[i]
+			if info.WhatsmeowType != tt.whatsmeowType || info.ForwardedByDefault != tt.forwarded {
+				t.Fatalf("registry entry = %+v, want type %q forwarded %v", info, tt.whatsmeowType, tt.forwarded)
+			}
+		})
+	}
+}
+
+func TestHandleEventRoutes(t *testing.T) {
+	contact := types.NewJID("5511888888888", types.DefaultUserServer)
+
+	tests := []struct {
+		name string
+		evt  interface{}
+		want string
+	}{
+		{name: "receipt", evt: &events.Receipt{}, want: EventReceipt},
+		{name: "presence", evt: &events.Presence{From: contact}, want: EventPresence},
+		{name: "push name", evt: &events.PushName{JID: contact, NewPushName: "Ana"}, want: EventPushName},
+		{name: "call terminate", evt: &events.CallTerminate{BasicCallMeta: types.BasicCallMeta{From: contact, CallID: "call-1"}}, want: EventCallTerminate},
+		{name: "unmapped falls back to unknown", evt: &events.ChatPresence{}, want: EventUnknown},
+		{name: "unmapped value type falls back to unknown", evt: events.Receipt{}, want: EventUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, client := newTestHandler(newFakeSessionRepo(), time.Hour, "s1")
+			defer h.Remove("s1")
+			recorder := subscribeAll(h)
+
+			deliver(client, tt.evt)
+
+			dispatched, ok := recorder.waitFor(tt.want)
+			if !ok {
+				t.Fatalf("%s not dispatched, got %v", tt.want, recorder.eventTypes())
+			}
+			if !reflect.DeepEqual(dispatched.data, tt.evt) {
+				t.Fatalf("dispatched %v, want the original event", dispatched.data)
+			}
+			if dispatchedTypes := recorder.eventTypes(); len(dispatchedTypes) != 1 {
+				t.Fatalf("dispatched %v, want only %s", dispatchedTypes, tt.want)
+			}
+		})
+	}
+}
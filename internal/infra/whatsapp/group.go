@@ -0,0 +1,140 @@
+package whatsapp
+
+import (
+	"context"
+	"fmt"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/types"
+
+	"wazmeow/internal/domain/services"
+	"wazmeow/internal/infra/whatsapp/client"
+	"wazmeow/pkg/logger"
+)
+
+// Métodos de join conhecidos para participantes de grupo
+const (
+	joinMethodInviteRequest = "invite_request"
+)
+
+// GetGroupInfo obtém informações de um grupo do qual a sessão participa
+func (s *Service) GetGroupInfo(ctx context.Context, sessionID, groupJID string) (*services.GroupInfo, error) {
+	client, err := s.getLoggedInClient(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	jid, err := parseGroupJID(groupJID)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := client.GetGroupInfo(jid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group info: %w", err)
+	}
+
+	participants := make([]services.GroupParticipant, len(info.Participants))
+	for i, participant := range info.Participants {
+		participants[i] = services.GroupParticipant{
+			JID:          participant.JID.String(),
+			Phone:        resolveParticipantPhone(ctx, client, participant),
+			IsAdmin:      participant.IsAdmin || participant.IsSuperAdmin,
+			IsSuperAdmin: participant.IsSuperAdmin,
+		}
+		if participant.AddRequest != nil {
+			participants[i].JoinMethod = joinMethodInviteRequest
+		}
+	}
+
+	logger.Debug().
+		Str("sessionID", sessionID).
+		Str("groupJID", jid.String()).
+		Int("participants", len(participants)).
+		Msg("Group info retrieved")
+
+	return &services.GroupInfo{
+		JID:          info.JID.String(),
+		Name:         info.Name,
+		Participants: participants,
+	}, nil
+}
+
+// GetGroupSettings obtém as configurações atuais de um grupo do qual a sessão participa
+func (s *Service) GetGroupSettings(ctx context.Context, sessionID, groupJID string) (*services.GroupSettings, error) {
+	sender, err := s.getLoggedInClient(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return fetchGroupSettings(sender, groupJID)
+}
+
+// fetchGroupSettings consulta um grupo e extrai suas configurações
+func fetchGroupSettings(sender client.WhatsAppSender, groupJID string) (*services.GroupSettings, error) {
+	jid, err := parseGroupJID(groupJID)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := sender.GetGroupInfo(jid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group info: %w", err)
+	}
+
+	return groupSettings(info), nil
+}
+
+// groupSettings extrai as configurações de um grupo das suas informações.
+// O whatsmeow não tem getters dedicados para modo de adição, aprovação de entrada ou
+// mensagens temporárias: GetGroupInfo lê todos do mesmo metadado que SetGroupMemberAddMode,
+// SetGroupJoinApprovalMode e SetDisappearingTimer alteram.
+func groupSettings(info *types.GroupInfo) *services.GroupSettings {
+	settings := &services.GroupSettings{
+		JID:                  info.JID.String(),
+		Announce:             info.IsAnnounce,
+		Locked:               info.IsLocked,
+		MemberAddMode:        string(info.MemberAddMode),
+		JoinApprovalRequired: info.IsJoinApprovalRequired,
+	}
+	if info.IsEphemeral {
+		settings.DisappearingTimer = info.DisappearingTimer
+	}
+	return settings
+}
+
+// parseGroupJID converte e valida um JID de grupo
+func parseGroupJID(groupJID string) (types.JID, error) {
+	jid, err := types.ParseJID(groupJID)
+	if err != nil {
+		return types.EmptyJID, fmt.Errorf("invalid group JID %q: %w", groupJID, err)
+	}
+	if jid.Server != types.GroupServer {
+		return types.EmptyJID, fmt.Errorf("invalid group JID %q: not a group", groupJID)
+	}
+	return jid, nil
+}
+
+// resolveParticipantPhone resolve o telefone do participante, usando o mapeamento LID quando necessário
+func resolveParticipantPhone(ctx context.Context, client *whatsmeow.Client, participant types.GroupParticipant) string {
+	if !participant.PhoneNumber.IsEmpty() {
+		return participant.PhoneNumber.User
+	}
+	if participant.JID.Server == types.DefaultUserServer {
+		return participant.JID.User
+	}
+
+	lid := participant.LID
+	if lid.IsEmpty() && participant.JID.Server == types.HiddenUserServer {
+		lid = participant.JID
+	}
+	if lid.IsEmpty() {
+		return ""
+	}
+
+	pn, err := client.Store.LIDs.GetPNForLID(ctx, lid)
+	if err != nil || pn.IsEmpty() {
+		// Número não resolvível: fica em branco
+		return ""
+	}
+	return pn.User
+}
@@ -0,0 +1,117 @@
+package whatsapp
+
+import (
+	"errors"
+	"testing"
+
+	"go.mau.fi/whatsmeow/types"
+
+	"wazmeow/internal/domain/services"
+	"wazmeow/internal/infra/whatsapp/client"
+)
+
+// mockGroupSender responde às consultas de grupo no lugar do cliente whatsmeow
+type mockGroupSender struct {
+	client.WhatsAppSender
+	groups  map[types.JID]*types.GroupInfo
+	queried []types.JID
+}
+
+func (m *mockGroupSender) GetGroupInfo(jid types.JID) (*types.GroupInfo, error) {
+	m.queried = append(m.queried, jid)
+	info, ok := m.groups[jid]
+	if !ok {
+		return nil, errors.New("item-not-found")
+	}
+	return info, nil
+}
+
+func TestGroupSettings(t *testing.T) {
+	jid := types.NewJID("120363000000000000", types.GroupServer)
+
+	tests := []struct {
+		name string
+		info types.GroupInfo
+		want services.GroupSettings
+	}{
+		{
+			name: "defaults",
+			info: types.GroupInfo{JID: jid, MemberAddMode: types.GroupMemberAddModeAllMember},
+			want: services.GroupSettings{JID: jid.String(), MemberAddMode: "all_member_add"},
+		},
+		{
+			name: "all restrictions",
+			info: types.GroupInfo{
+				JID:                         jid,
+				GroupAnnounce:               types.GroupAnnounce{IsAnnounce: true},
+				GroupLocked:                 types.GroupLocked{IsLocked: true},
+				GroupEphemeral:              types.GroupEphemeral{IsEphemeral: true, DisappearingTimer: 86400},
+				GroupMembershipApprovalMode: types.GroupMembershipApprovalMode{IsJoinApprovalRequired: true},
+				MemberAddMode:               types.GroupMemberAddModeAdmin,
+			},
+			want: services.GroupSettings{
+				JID:                  jid.String(),
+				Announce:             true,
+				Locked:               true,
+				MemberAddMode:        "admin_add",
+				JoinApprovalRequired: true,
+				DisappearingTimer:    86400,
+			},
+		},
+		{
+			name: "stale timer ignored when not ephemeral",
+			info: types.GroupInfo{JID: jid, GroupEphemeral: types.GroupEphemeral{DisappearingTimer: 604800}},
+			want: services.GroupSettings{JID: jid.String()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := groupSettings(&tt.info); *got != tt.want {
+				t.Fatalf("groupSettings() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFetchGroupSettings(t *testing.T) {
+	jid := types.NewJID("120363000000000000", types.GroupServer)
+	sender := &mockGroupSender{groups: map[types.JID]*types.GroupInfo{
+		jid: {JID: jid, GroupLocked: types.GroupLocked{IsLocked: true}, MemberAddMode: types.GroupMemberAddModeAdmin},
+	}}
+
+	tests := []struct {
+		name      string
+		groupJID  string
+		want      *services.GroupSettings
+		wantQuery bool
+	}{
+		{name: "known group", groupJID: jid.String(), want: &services.GroupSettings{JID: jid.String(), Locked: true, MemberAddMode: "admin_add"}, wantQuery: true},
+		{name: "unknown group", groupJID: "120363999999999999@g.us", wantQuery: true},
+		{name: "not a group JID", groupJID: "5511888888888@s.whatsapp.net"},
+		{name: "invalid JID", groupJID: "a@b@c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sender.queried = nil
+
+			got, err := fetchGroupSettings(sender, tt.groupJID)
+			if (len(sender.queried) > 0) != tt.wantQuery {
+				t.Fatalf("queried = %v, want query %v", sender.queried, tt.wantQuery)
+			}
+			if tt.want == nil {
+				if err == nil {
+					t.Fatalf("fetchGroupSettings() = %+v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("fetchGroupSettings() error = %v", err)
+			}
+			if *got != *tt.want {
+				t.Fatalf("fetchGroupSettings() = %+v, want %+v", *got, *tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package whatsapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/appstate"
+
+	"wazmeow/internal/domain/services"
+	"wazmeow/pkg/logger"
+)
+
+// GetPushName retorna o push name atual da conta e se diverge do nome da sessão
+func (s *Service) GetPushName(ctx context.Context, sessionID string) (*services.PushNameInfo, error) {
+	client, err := s.getLoggedInClient(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	if session == nil {
+		return nil, fmt.Errorf("session %s not found", sessionID)
+	}
+
+	return pushNameInfo(client.Store.PushName, session.Name), nil
+}
+
+// pushNameInfo compara o push name da conta com o nome da sessão.
+// A comparação é exata: o WhatsApp exibe o push name como foi definido.
+func pushNameInfo(pushName, sessionName string) *services.PushNameInfo {
+	return &services.PushNameInfo{
+		PushName:    pushName,
+		SessionName: sessionName,
+		Diverged:    pushName != sessionName,
+	}
+}
+
+// SyncPushName define o push name da conta como o nome da sessão
+func (s *Service) SyncPushName(ctx context.Context, sessionID string) (*services.PushNameInfo, error) {
+	info, err := s.GetPushName(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if !info.Diverged {
+		return info, nil
+	}
+
+	client, err := s.getLoggedInClient(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.SendAppState(ctx, appstate.BuildSettingPushName(info.SessionName)); err != nil {
+		return nil, fmt.Errorf("failed to set push name: %w", err)
+	}
+
+	logger.Info().
+		Str("sessionID", sessionID).
+		Str("from", info.PushName).
+		Str("to", info.SessionName).
+		Msg("Push name synced")
+
+	// Store.PushName só é atualizado quando a mutação volta do servidor, então a
+	// resposta é montada a partir do nome enviado
+	return pushNameInfo(info.SessionName, info.SessionName), nil
+}
+
+// GetProfilePicture obtém a foto de perfil da própria conta e atualiza o ID em cache
+func (s *Service) GetProfilePicture(ctx context.Context, sessionID string) (*services.ProfilePicture, error) {
+	client, err := s.getLoggedInClient(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	ownJID := client.Store.ID.ToNonAD()
+	cachedID, cached := s.clientManager.ProfilePictureID(sessionID)
+
+	picture := &services.ProfilePicture{JID: ownJID.String()}
+
+	info, err := client.GetProfilePictureInfo(ownJID, &whatsmeow.GetProfilePictureParams{})
+	switch {
+	case errors.Is(err, whatsmeow.ErrProfilePictureNotSet):
+		picture.Changed = cached && cachedID != ""
+		s.clientManager.SetProfilePictureID(sessionID, "")
+		return picture, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get profile picture: %w", err)
+	case info == nil:
+		return picture, nil
+	}
+
+	picture.Set = true
+	picture.PictureID = info.ID
+	picture.URL = info.URL
+	picture.Type = info.Type
+	picture.Changed = cached && cachedID != info.ID
+
+	s.clientManager.SetProfilePictureID(sessionID, info.ID)
+	return picture, nil
+}
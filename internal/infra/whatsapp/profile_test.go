@@ -0,0 +1,30 @@
+package whatsapp
+
+import "testing"
+
+func TestPushNameInfo(t *testing.T) {
+	tests := []struct {
+		name         string
+		pushName     string
+		sessionName  string
+		wantDiverged bool
+	}{
+		{name: "same name", pushName: "Loja Centro", sessionName: "Loja Centro"},
+		{name: "different name", pushName: "Loja", sessionName: "Loja Centro", wantDiverged: true},
+		{name: "push name not set yet", pushName: "", sessionName: "Loja Centro", wantDiverged: true},
+		{name: "case differs", pushName: "loja centro", sessionName: "Loja Centro", wantDiverged: true},
+		{name: "trailing space differs", pushName: "Loja Centro ", sessionName: "Loja Centro", wantDiverged: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := pushNameInfo(tt.pushName, tt.sessionName)
+			if info.PushName != tt.pushName || info.SessionName != tt.sessionName {
+				t.Fatalf("pushNameInfo() = %+v, want names %q and %q", info, tt.pushName, tt.sessionName)
+			}
+			if info.Diverged != tt.wantDiverged {
+				t.Fatalf("Diverged = %v, want %v", info.Diverged, tt.wantDiverged)
+			}
+		})
+	}
+}
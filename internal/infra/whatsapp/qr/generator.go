@@ -2,6 +2,7 @@ package qr
 
 import (
 	"encoding/base64"
+	"io"
 	"os"
 
 	"github.com/mdp/qrterminal/v3"
@@ -9,11 +10,13 @@ import (
 )
 
 // Generator gera e exibe QR codes de forma otimizada
-type Generator struct{}
+type Generator struct {
+	out io.Writer // destino do QR no terminal
+}
 
-// NewGenerator cria um novo gerador de QR codes
+// NewGenerator cria um novo gerador de QR codes que exibe no stdout
 func NewGenerator() *Generator {
-	return &Generator{}
+	return &Generator{out: os.Stdout}
 }
 
 // GenerateBase64PNG gera QR code como PNG em base64
@@ -34,7 +37,7 @@ func (g *Generator) DisplayTerminal(code string) {
 	// Configurar para exibir no terminal
 	config := qrterminal.Config{
 		Level:     qrterminal.M,
-		Writer:    os.Stdout,
+		Writer:    g.out,
 		BlackChar: qrterminal.BLACK,
 		WhiteChar: qrterminal.WHITE,
 		QuietZone: 1,
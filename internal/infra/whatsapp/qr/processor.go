@@ -2,7 +2,10 @@ package qr
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.mau.fi/whatsmeow"
@@ -13,11 +16,34 @@ import (
 	"wazmeow/pkg/logger"
 )
 
+// ErrQRCancelled indica que o loop de QR foi substituído ou cancelado
+var ErrQRCancelled = errors.New("QR process cancelled")
+
+// qrCloseGrace limita a espera pelo fechamento do canal de QR após o cancelamento
+const qrCloseGrace = time.Second
+
+// Client define o subconjunto do cliente whatsmeow usado pelo loop de QR
+type Client interface {
+	GetQRChannel(ctx context.Context) (<-chan whatsmeow.QRChannelItem, error)
+	Connect() error
+}
+
 // Processor gerencia processamento de QR codes de forma otimizada
 type Processor struct {
 	generator   *Generator
 	timeout     time.Duration
+	terminal    bool
 	sessionRepo repositories.SessionRepository
+	mu          sync.Mutex
+	loops       map[string]*Loop // no máximo um loop registrado por sessão
+	active      int64
+}
+
+// Loop representa um loop de QR registrado para uma sessão
+type Loop struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{} // fechado quando Process termina
 }
 
 // NewProcessor cria um novo processador de QR
@@ -25,20 +51,45 @@ func NewProcessor(sessionRepo repositories.SessionRepository, config *config.Wha
 	return &Processor{
 		generator:   NewGenerator(),
 		timeout:     config.QRTimeout,
+		terminal:    config.QRTerminalOutput,
 		sessionRepo: sessionRepo,
+		loops:       make(map[string]*Loop),
 	}
 }
 
-// Process processa QR code para uma sessão
-func (p *Processor) Process(ctx context.Context, client *whatsmeow.Client, sessionID string) error {
-	logger.Info().Str("sessionID", sessionID).Msg("Starting QR process")
+// Start registra um novo loop de QR para a sessão, substituindo o anterior em um único passo.
+// O loop anterior é cancelado e aguardado antes do retorno; o novo deve ser executado com Process.
+func (p *Processor) Start(ctx context.Context, sessionID string) (*Loop, bool) {
+	loopCtx, cancel := context.WithTimeout(ctx, p.timeout)
+	loop := &Loop{ctx: loopCtx, cancel: cancel, done: make(chan struct{})}
+
+	p.mu.Lock()
+	previous := p.loops[sessionID]
+	p.loops[sessionID] = loop
+	p.mu.Unlock()
+	atomic.AddInt64(&p.active, 1)
+
+	if previous == nil {
+		return loop, false
+	}
+	previous.cancel()
+	<-previous.done
+	return loop, true
+}
+
+// Process executa um loop registrado por Start até o pareamento, timeout ou cancelamento
+func (p *Processor) Process(loop *Loop, client Client, sessionID string) error {
+	defer p.finish(sessionID, loop)
 
-	// Context com timeout
-	qrCtx, cancel := context.WithTimeout(ctx, p.timeout)
-	defer cancel()
+	// Substituído antes de começar: não abrir um canal de QR que desconectaria o cliente
+	if loop.ctx.Err() != nil {
+		return ErrQRCancelled
+	}
+
+	logger.Info().Str("sessionID", sessionID).Msg("Starting QR process")
 
 	// Canal para receber QR codes
-	qrChan, err := client.GetQRChannel(qrCtx)
+	qrChan, err := client.GetQRChannel(loop.ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get QR channel: %w", err)
 	}
@@ -49,7 +100,41 @@ func (p *Processor) Process(ctx context.Context, client *whatsmeow.Client, sessi
 	}
 
 	// Processar eventos QR
-	return p.handleQREvents(qrCtx, sessionID, qrChan)
+	return p.handleQREvents(loop.ctx, sessionID, qrChan)
+}
+
+// finish remove o registro do loop, se ainda for o atual, e sinaliza seu término
+func (p *Processor) finish(sessionID string, loop *Loop) {
+	loop.cancel()
+
+	p.mu.Lock()
+	if p.loops[sessionID] == loop {
+		delete(p.loops, sessionID)
+	}
+	p.mu.Unlock()
+
+	atomic.AddInt64(&p.active, -1)
+	close(loop.done)
+}
+
+// Cancel cancela o loop de QR ativo de uma sessão, se houver, e aguarda seu término
+func (p *Processor) Cancel(sessionID string) bool {
+	p.mu.Lock()
+	loop, ok := p.loops[sessionID]
+	delete(p.loops, sessionID)
+	p.mu.Unlock()
+
+	if !ok {
+		return false
+	}
+	loop.cancel()
+	<-loop.done
+	return true
+}
+
+// ActiveLoops retorna o número de loops de QR em execução
+func (p *Processor) ActiveLoops() int64 {
+	return atomic.LoadInt64(&p.active)
 }
 
 // handleQREvents processa eventos QR com timeout
@@ -57,11 +142,24 @@ func (p *Processor) handleQREvents(ctx context.Context, sessionID string, qrChan
 	for {
 		select {
 		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.Canceled) {
+				awaitClose(qrChan)
+				logger.Info().Str("sessionID", sessionID).Msg("QR process cancelled")
+				return ErrQRCancelled
+			}
 			logger.Error().Str("sessionID", sessionID).Msg("QR process timeout")
 			p.updateSessionStatus(sessionID, entities.StatusDisconnected)
 			return fmt.Errorf("QR process timeout")
 
 		case evt, ok := <-qrChan:
+			// Após o cancelamento, canal fechado ou "timeout" vêm do próprio cancelamento
+			if errors.Is(ctx.Err(), context.Canceled) {
+				if ok {
+					awaitClose(qrChan)
+				}
+				logger.Info().Str("sessionID", sessionID).Msg("QR process cancelled")
+				return ErrQRCancelled
+			}
 			if !ok {
 				logger.Error().Str("sessionID", sessionID).Msg("QR channel closed")
 				return fmt.Errorf("QR channel closed")
@@ -80,6 +178,24 @@ func (p *Processor) handleQREvents(ctx context.Context, sessionID string, qrChan
 	}
 }
 
+// awaitClose aguarda o emissor do whatsmeow fechar o canal de QR após o cancelamento,
+// já que ele desconecta o cliente logo em seguida e não deve atingir uma nova conexão
+func awaitClose(qrChan <-chan whatsmeow.QRChannelItem) {
+	timer := time.NewTimer(qrCloseGrace)
+	defer timer.Stop()
+
+	for {
+		select {
+		case _, ok := <-qrChan:
+			if !ok {
+				return
+			}
+		case <-timer.C:
+			return
+		}
+	}
+}
+
 // processQREvent processa um evento QR específico
 func (p *Processor) processQREvent(sessionID string, evt whatsmeow.QRChannelItem) error {
 	switch evt.Event {
@@ -89,6 +205,11 @@ func (p *Processor) processQREvent(sessionID string, evt whatsmeow.QRChannelItem
 		logger.Error().Str("sessionID", sessionID).Msg("QR code timeout")
 		p.updateSessionStatus(sessionID, entities.StatusDisconnected)
 		return fmt.Errorf("QR code timeout")
+	case whatsmeow.QRChannelEventError:
+		// Pareamento falhou (ex: recusado pelo PairGuard); o whatsmeow já desconectou o cliente
+		logger.Error().Str("sessionID", sessionID).Err(evt.Error).Msg("QR pairing failed")
+		p.updateSessionStatus(sessionID, entities.StatusDisconnected)
+		return fmt.Errorf("pairing failed: %w", evt.Error)
 	case "success":
 		logger.Info().Str("sessionID", sessionID).Msg("QR authentication successful")
 		p.clearQRCode(sessionID)
@@ -102,7 +223,7 @@ func (p *Processor) processQREvent(sessionID string, evt whatsmeow.QRChannelItem
 
 // handleQRCode processa novo QR code
 func (p *Processor) handleQRCode(sessionID, code string) error {
-	logger.Info().Str("sessionID", sessionID).Msg("New QR code generated")
+	logger.Debug().Str("sessionID", sessionID).Msg("New QR code generated")
 
 	// Gerar PNG base64
 	base64PNG, err := p.generator.GenerateBase64PNG(code)
@@ -117,10 +238,12 @@ func (p *Processor) handleQRCode(sessionID, code string) error {
 		return fmt.Errorf("failed to save QR code: %w", err)
 	}
 
-	// Display no terminal (opcional)
-	p.generator.DisplayTerminal(code)
+	// Display no terminal (opcional, desativado via QR_TERMINAL_OUTPUT=false)
+	if p.terminal {
+		p.generator.DisplayTerminal(code)
+	}
 
-	logger.Info().Str("sessionID", sessionID).Msg("QR code saved and displayed")
+	logger.Debug().Str("sessionID", sessionID).Bool("terminal", p.terminal).Msg("QR code saved")
 	return nil
 }
 
@@ -0,0 +1,155 @@
+package qr
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+
+	"wazmeow/internal/config"
+)
+
+// fakeClient emite o canal de QR como o whatsmeow: fechado quando o contexto termina
+type fakeClient struct{}
+
+func (fakeClient) GetQRChannel(ctx context.Context) (<-chan whatsmeow.QRChannelItem, error) {
+	qrChan := make(chan whatsmeow.QRChannelItem)
+	go func() {
+		<-ctx.Done()
+		close(qrChan)
+	}()
+	return qrChan, nil
+}
+
+func (fakeClient) Connect() error {
+	return nil
+}
+
+func newTestProcessor() *Processor {
+	return NewProcessor(nil, &config.WhatsAppConfig{QRTimeout: time.Minute})
+}
+
+// run executa o loop em uma goroutine e envia seu resultado ao canal
+func run(p *Processor, loop *Loop, sessionID string, results chan<- error) {
+	go func() {
+		results <- p.Process(loop, fakeClient{}, sessionID)
+	}()
+}
+
+func TestProcessorReplacesLoops(t *testing.T) {
+	tests := []struct {
+		name       string
+		rounds     int
+		concurrent bool
+	}{
+		{name: "sequential reconnects", rounds: 50},
+		{name: "concurrent reconnects", rounds: 50, concurrent: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseline := runtime.NumGoroutine()
+			p := newTestProcessor()
+			results := make(chan error, tt.rounds)
+
+			connect := func() {
+				loop, _ := p.Start(context.Background(), "s1")
+				run(p, loop, "s1", results)
+			}
+
+			if tt.concurrent {
+				var wg sync.WaitGroup
+				for i := 0; i < tt.rounds; i++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						connect()
+					}()
+				}
+				wg.Wait()
+			} else {
+				for i := 0; i < tt.rounds; i++ {
+					connect()
+					if active := p.ActiveLoops(); active != 1 {
+						t.Fatalf("round %d: ActiveLoops() = %d, want 1", i, active)
+					}
+				}
+			}
+
+			if active := p.ActiveLoops(); active != 1 {
+				t.Fatalf("ActiveLoops() after reconnects = %d, want 1", active)
+			}
+			if !p.Cancel("s1") {
+				t.Fatalf("Cancel() found no loop to cancel")
+			}
+			if active := p.ActiveLoops(); active != 0 {
+				t.Fatalf("ActiveLoops() after cancel = %d, want 0", active)
+			}
+
+			for i := 0; i < tt.rounds; i++ {
+				if err := <-results; !errors.Is(err, ErrQRCancelled) {
+					t.Fatalf("Process() = %v, want ErrQRCancelled", err)
+				}
+			}
+			assertGoroutines(t, baseline)
+		})
+	}
+}
+
+func TestProcessorStartReportsReplacement(t *testing.T) {
+	p := newTestProcessor()
+	results := make(chan error, 2)
+
+	first, replaced := p.Start(context.Background(), "s1")
+	if replaced {
+		t.Fatalf("first Start() replaced a loop")
+	}
+	run(p, first, "s1", results)
+
+	second, replaced := p.Start(context.Background(), "s1")
+	if !replaced {
+		t.Fatalf("second Start() did not replace the first loop")
+	}
+	if err := <-results; !errors.Is(err, ErrQRCancelled) {
+		t.Fatalf("replaced Process() = %v, want ErrQRCancelled", err)
+	}
+
+	// Outra sessão não é afetada
+	other, replaced := p.Start(context.Background(), "s2")
+	if replaced {
+		t.Fatalf("Start() for another session replaced a loop")
+	}
+	run(p, second, "s1", results)
+	run(p, other, "s2", results)
+	if active := p.ActiveLoops(); active != 2 {
+		t.Fatalf("ActiveLoops() = %d, want 2", active)
+	}
+
+	p.Cancel("s1")
+	p.Cancel("s2")
+	<-results
+	<-results
+	if p.Cancel("s1") {
+		t.Fatalf("Cancel() reported a loop after it finished")
+	}
+}
+
+// assertGoroutines aguarda o número de goroutines voltar ao valor inicial
+func assertGoroutines(t *testing.T, baseline int) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		current := runtime.NumGoroutine()
+		if current <= baseline {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("goroutines = %d, want at most %d", current, baseline)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
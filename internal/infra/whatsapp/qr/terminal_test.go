@@ -0,0 +1,60 @@
+package qr
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"wazmeow/internal/config"
+	"wazmeow/internal/domain/entities"
+	"wazmeow/internal/domain/repositories"
+)
+
+// memoryRepo guarda as sessões em memória; apenas GetByID e Update são usados pelo processor
+type memoryRepo struct {
+	repositories.SessionRepository
+	sessions map[string]*entities.Session
+}
+
+func (r *memoryRepo) GetByID(_ context.Context, id string) (*entities.Session, error) {
+	return r.sessions[id], nil
+}
+
+func (r *memoryRepo) Update(_ context.Context, session *entities.Session) error {
+	r.sessions[session.ID] = session
+	return nil
+}
+
+func TestHandleQRCodeTerminalOutput(t *testing.T) {
+	tests := []struct {
+		name         string
+		terminal     bool
+		wantTerminal bool
+	}{
+		{name: "terminal enabled", terminal: true, wantTerminal: true},
+		{name: "terminal disabled", terminal: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := entities.NewSession("qr")
+			repo := &memoryRepo{sessions: map[string]*entities.Session{session.ID: session}}
+			p := NewProcessor(repo, &config.WhatsAppConfig{QRTimeout: time.Minute, QRTerminalOutput: tt.terminal})
+			var out bytes.Buffer
+			p.generator.out = &out
+
+			if err := p.handleQRCode(session.ID, "2@abc,def,ghi"); err != nil {
+				t.Fatalf("handleQRCode() error = %v", err)
+			}
+
+			if written := out.Len() > 0; written != tt.wantTerminal {
+				t.Fatalf("terminal output written = %v, want %v", written, tt.wantTerminal)
+			}
+			// O QR continua disponível para a API independente do terminal
+			if repo.sessions[session.ID].QRCode == "" {
+				t.Fatalf("QR code not saved for the API")
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package whatsapp
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.mau.fi/whatsmeow"
 	"go.mau.fi/whatsmeow/store/sqlstore"
@@ -12,6 +13,7 @@ import (
 	"wazmeow/internal/domain/repositories"
 	"wazmeow/internal/domain/services"
 	"wazmeow/internal/infra/whatsapp/client"
+	"wazmeow/internal/infra/whatsapp/events"
 	"wazmeow/pkg/logger"
 )
 
@@ -106,18 +108,18 @@ func (s *Service) attemptReconnect(ctx context.Context, sessionID string) {
 	}
 }
 
-// StartSession starts a WhatsApp session
+// StartSession starts a WhatsApp session, creating its client if it is not loaded yet.
+// Calling it again for a loaded session reconnects it or restarts its QR loop
+// instead of failing with "already started".
 func (s *Service) StartSession(ctx context.Context, sessionID string) error {
-	// Check if session already exists
-	if s.clientManager.Has(sessionID) {
-		return fmt.Errorf("session %s already started", sessionID)
-	}
-
 	// Get session from database
 	session, err := s.sessionRepo.GetByID(ctx, sessionID)
 	if err != nil {
 		return fmt.Errorf("failed to get session: %w", err)
 	}
+	if session == nil {
+		return fmt.Errorf("session %s not found", sessionID)
+	}
 
 	// Update status to connecting
 	session.Status = entities.StatusConnecting
@@ -125,14 +127,21 @@ func (s *Service) StartSession(ctx context.Context, sessionID string) error {
 		logger.Error().Err(err).Str("sessionID", sessionID).Msg("Failed to update session status to connecting")
 	}
 
-	// Use ClientManager to create new session
-	return s.clientManager.Create(ctx, sessionID)
+	// Create the client if it was not loaded yet
+	if !s.clientManager.Has(sessionID) {
+		if err := s.clientManager.Create(ctx, sessionID); err != nil {
+			return err
+		}
+	}
+
+	// Connect directly or start the QR loop (replacing any previous one)
+	return s.clientManager.Connect(ctx, sessionID)
 }
 
 // StopSession stops a WhatsApp session
 func (s *Service) StopSession(ctx context.Context, sessionID string) error {
 	if !s.clientManager.Has(sessionID) {
-		return fmt.Errorf("session %s not found", sessionID)
+		return fmt.Errorf("%w: %s", services.ErrSessionNotRunning, sessionID)
 	}
 
 	// Disconnect session using ClientManager
@@ -161,6 +170,11 @@ func (s *Service) GetQRCode(ctx context.Context, sessionID string) (string, erro
 		return "", fmt.Errorf("no session")
 	}
 
+	// Pairing refused because the account does not match the claimed phone
+	if err := s.clientManager.PairRejection(sessionID); err != nil {
+		return "", err
+	}
+
 	// Check if already logged in
 	wrapper := s.clientManager.Get(sessionID)
 	if wrapper != nil && wrapper.IsLoggedIn() {
@@ -254,6 +268,8 @@ func (s *Service) GetSessionInfo(sessionID string) (*services.SessionInfo, error
 		if !jid.IsEmpty() {
 			info.DeviceJID = jid.String()
 		}
+		info.Debug = wrapper.IsDebug()
+		info.Ready = info.Connected && s.clientManager.IsReady(sessionID)
 	}
 
 	return info, nil
@@ -312,6 +328,86 @@ func (s *Service) GetAllSessionsInfo() []map[string]interface{} {
 	return result
 }
 
+// GetStats retorna estatísticas dos clientes, incluindo os loops de QR ativos
+func (s *Service) GetStats() map[string]interface{} {
+	return s.clientManager.GetStats()
+}
+
+// GetRecentEvents retorna os eventos recentes de uma sessão para replay
+func (s *Service) GetRecentEvents(sessionID string, since time.Time) ([]services.RecordedEvent, error) {
+	if !s.clientManager.Has(sessionID) {
+		return nil, fmt.Errorf("session %s not found", sessionID)
+	}
+	return s.clientManager.RecentEvents(sessionID, since), nil
+}
+
+// GetEventHandlers retorna os IDs dos event handlers registrados no cliente de uma sessão
+func (s *Service) GetEventHandlers(sessionID string) ([]uint32, error) {
+	if !s.clientManager.Has(sessionID) {
+		return nil, fmt.Errorf("session %s not found", sessionID)
+	}
+	return s.clientManager.EventHandlerIDs(sessionID), nil
+}
+
+// ResetEventHandlers remove os event handlers registrados pelo servidor no cliente e registra novamente o conjunto padrão
+func (s *Service) ResetEventHandlers(sessionID string) ([]uint32, error) {
+	ids, ok := s.clientManager.ResetEventHandlers(sessionID)
+	if !ok {
+		return nil, fmt.Errorf("session %s not found", sessionID)
+	}
+	return ids, nil
+}
+
+// EventRegistry retorna os tipos de evento tratados e seus nomes normalizados
+func (s *Service) EventRegistry() []services.EventTypeInfo {
+	return events.Registry()
+}
+
+// SetDebug ativa ou desativa o log verboso do cliente whatsmeow de uma sessão
+func (s *Service) SetDebug(sessionID string, enabled bool) error {
+	wrapper := s.clientManager.Get(sessionID)
+	if wrapper == nil {
+		return fmt.Errorf("session %s not found", sessionID)
+	}
+
+	if !wrapper.SetDebug(enabled) {
+		return fmt.Errorf("session %s does not support verbose logging", sessionID)
+	}
+
+	logger.Info().Str("sessionID", sessionID).Bool("enabled", enabled).Msg("Client debug logging updated")
+	return nil
+}
+
+// SetAutoMarkRead atualiza a flag de leitura automática usada pelo handler de eventos
+func (s *Service) SetAutoMarkRead(sessionID string, enabled bool) {
+	s.clientManager.SetAutoMarkRead(sessionID, enabled)
+}
+
+// OnSessionRemoved registra uma função chamada quando o cliente de uma sessão é removido
+func (s *Service) OnSessionRemoved(hook func(sessionID string)) {
+	s.clientManager.OnRemove(hook)
+}
+
+// SetAutoReject atualiza as configurações de rejeição automática usadas pelo handler de eventos
+func (s *Service) SetAutoReject(sessionID string, enabled bool, message string) {
+	s.clientManager.SetAutoReject(sessionID, enabled, message)
+}
+
+// getLoggedInClient retorna o cliente WhatsApp de uma sessão autenticada
+func (s *Service) getLoggedInClient(sessionID string) (*whatsmeow.Client, error) {
+	wrapper := s.clientManager.Get(sessionID)
+	if wrapper == nil {
+		return nil, fmt.Errorf("session %s not found", sessionID)
+	}
+
+	client := wrapper.Client()
+	if client == nil || !client.IsLoggedIn() {
+		return nil, fmt.Errorf("session %s is not logged in", sessionID)
+	}
+
+	return client, nil
+}
+
 // NOTA: Métodos de conexão removidos - agora gerenciados pelo ClientManager
 
 // Shutdown para o service e todas as sessões
@@ -39,6 +39,7 @@ func NewContainer(cfg config.DatabaseConfig) (*sqlstore.Container, error) {
 	err := container.Upgrade(ctx)
 	if err != nil {
 		logger.Error().Err(err).Msg("Failed to upgrade WhatsApp store schema")
+		_ = sqldb.Close()
 		return nil, err
 	}
 
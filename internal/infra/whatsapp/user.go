@@ -0,0 +1,149 @@
+package whatsapp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+
+	"wazmeow/internal/domain/services"
+	"wazmeow/pkg/logger"
+)
+
+// GetUserDevices obtém os devices de um contato e se há sessão de criptografia com cada um
+func (s *Service) GetUserDevices(ctx context.Context, sessionID, phone string) ([]services.UserDevice, error) {
+	client, err := s.getLoggedInClient(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	jid, err := parsePhoneJID(phone)
+	if err != nil {
+		return nil, err
+	}
+
+	return listUserDevices(ctx, client, client.Store.Sessions, sessionID, jid)
+}
+
+// sessionChecker é a parte do store do whatsmeow que informa se há sessão de criptografia
+type sessionChecker interface {
+	HasSession(ctx context.Context, address string) (bool, error)
+}
+
+// listUserDevices busca os devices de um contato e marca os que não têm sessão de criptografia
+func listUserDevices(ctx context.Context, client contactResolver, sessions sessionChecker, sessionID string, jid types.JID) ([]services.UserDevice, error) {
+	deviceJIDs, err := client.GetUserDevicesContext(ctx, []types.JID{jid})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user devices: %w", err)
+	}
+
+	devices := make([]services.UserDevice, len(deviceJIDs))
+	for i, deviceJID := range deviceJIDs {
+		hasSession, err := sessions.HasSession(ctx, deviceJID.SignalAddress().String())
+		if err != nil {
+			return nil, fmt.Errorf("failed to check session for device %s: %w", deviceJID.String(), err)
+		}
+
+		devices[i] = services.UserDevice{
+			JID:        deviceJID.String(),
+			HasSession: hasSession,
+		}
+
+		if !hasSession {
+			logger.Debug().
+				Str("sessionID", sessionID).
+				Str("device", deviceJID.String()).
+				Msg("Device has no encryption session")
+		}
+	}
+
+	return devices, nil
+}
+
+// contactLookupInterval é o intervalo mínimo entre consultas de contatos
+const contactLookupInterval = 250 * time.Millisecond
+
+// PrefetchContacts consulta info e devices de cada contato, aquecendo o cache de devices
+// antes do primeiro envio. Não cria sessões Signal: o whatsmeow só busca prekeys ao enviar.
+// As consultas são espaçadas para evitar rate limit do servidor.
+func (s *Service) PrefetchContacts(ctx context.Context, sessionID string, phones []string) ([]services.ContactPrefetchResult, error) {
+	client, err := s.getLoggedInClient(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	ticker := time.NewTicker(contactLookupInterval)
+	defer ticker.Stop()
+
+	results := make([]services.ContactPrefetchResult, len(phones))
+	for i, phone := range phones {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-ticker.C:
+			}
+		}
+
+		results[i] = prefetchContact(ctx, client, phone)
+	}
+
+	logger.Info().Str("sessionID", sessionID).Int("contacts", len(phones)).Msg("Contacts prefetched")
+	return results, nil
+}
+
+// contactResolver é a parte do cliente whatsmeow usada para resolver contatos
+type contactResolver interface {
+	GetUserInfo(jids []types.JID) (map[types.JID]types.UserInfo, error)
+	GetUserDevicesContext(ctx context.Context, jids []types.JID) ([]types.JID, error)
+}
+
+// prefetchContact resolve um único contato e busca seus devices
+func prefetchContact(ctx context.Context, client contactResolver, phone string) services.ContactPrefetchResult {
+	result := services.ContactPrefetchResult{Phone: phone}
+
+	jid, err := parsePhoneJID(phone)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.JID = jid.String()
+
+	info, err := client.GetUserInfo([]types.JID{jid})
+	if err != nil {
+		result.Error = fmt.Sprintf("failed to get user info: %v", err)
+		return result
+	}
+	if _, ok := info[jid]; !ok {
+		result.Error = "not on WhatsApp"
+		return result
+	}
+
+	devices, err := client.GetUserDevicesContext(ctx, []types.JID{jid})
+	if err != nil {
+		result.Error = fmt.Sprintf("failed to get user devices: %v", err)
+		return result
+	}
+
+	result.Resolved = true
+	result.Devices = len(devices)
+	return result
+}
+
+// parsePhoneJID converte um número de telefone em JID de usuário
+func parsePhoneJID(phone string) (types.JID, error) {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+
+	if digits == "" {
+		return types.EmptyJID, fmt.Errorf("invalid phone number %q", phone)
+	}
+
+	return types.NewJID(digits, types.DefaultUserServer), nil
+}
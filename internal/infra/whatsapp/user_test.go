@@ -0,0 +1,146 @@
+package whatsapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// fakeResolver responde como o whatsmeow para os contatos registrados
+type fakeResolver struct {
+	users       map[string]int // usuário -> número de devices
+	infoErr     error
+	devicesErr  error
+	devicesSeen []types.JID
+}
+
+func (f *fakeResolver) GetUserInfo(jids []types.JID) (map[types.JID]types.UserInfo, error) {
+	if f.infoErr != nil {
+		return nil, f.infoErr
+	}
+	info := make(map[types.JID]types.UserInfo)
+	for _, jid := range jids {
+		if _, ok := f.users[jid.User]; ok {
+			info[jid] = types.UserInfo{}
+		}
+	}
+	return info, nil
+}
+
+func (f *fakeResolver) GetUserDevicesContext(_ context.Context, jids []types.JID) ([]types.JID, error) {
+	f.devicesSeen = append(f.devicesSeen, jids...)
+	if f.devicesErr != nil {
+		return nil, f.devicesErr
+	}
+	var devices []types.JID
+	for _, jid := range jids {
+		for i := 0; i < f.users[jid.User]; i++ {
+			devices = append(devices, types.JID{User: jid.User, Device: uint16(i), Server: types.DefaultUserServer})
+		}
+	}
+	return devices, nil
+}
+
+func TestPrefetchContact(t *testing.T) {
+	tests := []struct {
+		name         string
+		phone        string
+		infoErr      error
+		devicesErr   error
+		wantResolved bool
+		wantDevices  int
+		wantFetch    bool
+	}{
+		{name: "resolved", phone: "+55 11 99999-9999", wantResolved: true, wantDevices: 2, wantFetch: true},
+		{name: "invalid phone", phone: "abc"},
+		{name: "not on whatsapp", phone: "5511000000000"},
+		{name: "user info error", phone: "5511999999999", infoErr: errors.New("timeout")},
+		{name: "devices error", phone: "5511999999999", devicesErr: errors.New("timeout"), wantFetch: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeResolver{
+				users:      map[string]int{"5511999999999": 2},
+				infoErr:    tt.infoErr,
+				devicesErr: tt.devicesErr,
+			}
+
+			result := prefetchContact(context.Background(), client, tt.phone)
+
+			if result.Phone != tt.phone {
+				t.Fatalf("Phone = %q, want %q", result.Phone, tt.phone)
+			}
+			if result.Resolved != tt.wantResolved || result.Devices != tt.wantDevices {
+				t.Fatalf("result = %+v, want resolved %v with %d devices", result, tt.wantResolved, tt.wantDevices)
+			}
+			if tt.wantResolved == (result.Error != "") {
+				t.Fatalf("Error = %q with resolved %v", result.Error, result.Resolved)
+			}
+			if fetched := len(client.devicesSeen) > 0; fetched != tt.wantFetch {
+				t.Fatalf("devices fetched = %v, want %v", fetched, tt.wantFetch)
+			}
+		})
+	}
+}
+
+// fakeSessions conhece as sessões de criptografia pelo endereço Signal
+type fakeSessions struct {
+	addresses map[string]bool
+	err       error
+}
+
+func (f *fakeSessions) HasSession(_ context.Context, address string) (bool, error) {
+	return f.addresses[address], f.err
+}
+
+func TestListUserDevices(t *testing.T) {
+	jid := types.NewJID("5511999999999", types.DefaultUserServer)
+	device := func(id uint16) types.JID {
+		return types.JID{User: jid.User, Device: id, Server: types.DefaultUserServer}
+	}
+	errStore := errors.New("store closed")
+
+	tests := []struct {
+		name        string
+		devices     int
+		withSession []types.JID
+		storeErr    error
+		wantSession []bool
+		wantErr     error
+	}{
+		{name: "all with sessions", devices: 2, withSession: []types.JID{device(0), device(1)}, wantSession: []bool{true, true}},
+		{name: "some without sessions", devices: 3, withSession: []types.JID{device(0), device(2)}, wantSession: []bool{true, false, true}},
+		{name: "none with sessions", devices: 2, wantSession: []bool{false, false}},
+		{name: "store error", devices: 2, storeErr: errStore, wantErr: errStore},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessions := &fakeSessions{addresses: make(map[string]bool), err: tt.storeErr}
+			for _, d := range tt.withSession {
+				sessions.addresses[d.SignalAddress().String()] = true
+			}
+			client := &fakeResolver{users: map[string]int{jid.User: tt.devices}}
+
+			devices, err := listUserDevices(context.Background(), client, sessions, "s1", jid)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("listUserDevices() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if len(devices) != len(tt.wantSession) {
+				t.Fatalf("got %d devices, want %d", len(devices), len(tt.wantSession))
+			}
+			for i, d := range devices {
+				if d.JID != device(uint16(i)).String() || d.HasSession != tt.wantSession[i] {
+					t.Fatalf("device %d = %+v, want %s with session %v", i, d, device(uint16(i)), tt.wantSession[i])
+				}
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -66,16 +67,29 @@ func Fatal() *zerolog.Event {
 
 // WAAdapter adapts our centralized logger to whatsmeow's log interface
 type WAAdapter struct {
-	module string
+	module  string
+	verbose *atomic.Bool // shared with sub-loggers
 }
 
 // NewWALogger creates a new WhatsApp logger adapter
 func NewWALogger(module string) waLog.Logger {
 	return &WAAdapter{
-		module: module,
+		module:  module,
+		verbose: &atomic.Bool{},
 	}
 }
 
+// SetVerbose toggles verbose output for this adapter and all its sub-loggers.
+// When verbose, debug messages are emitted at info level so they bypass the global level.
+func (w *WAAdapter) SetVerbose(verbose bool) {
+	w.verbose.Store(verbose)
+}
+
+// IsVerbose returns true if verbose output is enabled
+func (w *WAAdapter) IsVerbose() bool {
+	return w.verbose.Load()
+}
+
 // Errorf logs an error message
 func (w *WAAdapter) Errorf(msg string, args ...interface{}) {
 	Error().Str("module", w.module).Msgf(msg, args...)
@@ -93,12 +107,17 @@ func (w *WAAdapter) Infof(msg string, args ...interface{}) {
 
 // Debugf logs a debug message
 func (w *WAAdapter) Debugf(msg string, args ...interface{}) {
+	if w.verbose.Load() {
+		Info().Str("module", w.module).Bool("verbose", true).Msgf(msg, args...)
+		return
+	}
 	Debug().Str("module", w.module).Msgf(msg, args...)
 }
 
 // Sub creates a sub-logger with additional context
 func (w *WAAdapter) Sub(module string) waLog.Logger {
 	return &WAAdapter{
-		module: fmt.Sprintf("%s/%s", w.module, module),
+		module:  fmt.Sprintf("%s/%s", w.module, module),
+		verbose: w.verbose,
 	}
 }